@@ -1,10 +1,29 @@
 package main
 
 import (
+	"api_service/internal/auth"
+	"api_service/internal/clientip"
+	"api_service/internal/config"
 	grpccache "api_service/internal/grpc_cache"
+	"api_service/internal/grpcconn"
 	"api_service/internal/handlers"
-	"log"
+	"api_service/internal/health"
+	"api_service/internal/httplog"
+	"api_service/internal/metrics"
+	"api_service/internal/pagination"
+	"api_service/internal/ratelimit"
+	"api_service/internal/tracing"
+	"context"
+	"errors"
+	"grpcmw"
+	"io"
+	"log/slog"
 	"net/http"
+	"os"
+	"os/signal"
+	"platform/logging"
+	sharedtracing "platform/tracing"
+	"syscall"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -12,79 +31,150 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
-var (
-	httpRequestsTotal = prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "http_requests_total",
-			Help: "Total HTTP requests",
-		},
-		[]string{"method", "path", "status"},
-	)
-
-	httpRequestDuration = prometheus.NewHistogramVec(
-		prometheus.HistogramOpts{
-			Name:    "http_request_duration_seconds",
-			Help:    "HTTP request duration",
-			Buckets: []float64{0.1, 0.3, 1, 3, 5},
-		},
-		[]string{"path"},
-	)
-
-	cacheOperations = prometheus.NewCounterVec(
-		prometheus.CounterOpts{
-			Name: "cache_operations_total",
-			Help: "Total cache operations",
-		},
-		[]string{"operation", "status"},
-	)
-)
-
-func init() {
-	prometheus.MustRegister(httpRequestsTotal)
-	prometheus.MustRegister(httpRequestDuration)
-	prometheus.MustRegister(cacheOperations)
-}
-
-func startMetricsAndHealthServer() {
+func newMetricsAndHealthServer(addr string, readiness *health.Readiness, checker *health.Checker, admin http.Handler) *http.Server {
 	mux := http.NewServeMux()
 
 	// Эндпоинт для метрик Prometheus
 	mux.Handle("/metrics", promhttp.Handler())
 
-	// Эндпоинт для health check
-	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("OK"))
-	})
+	// /health - процесс жив, /ready - готов принимать трафик (503 во время остановки)
+	mux.HandleFunc("/health", readiness.HealthHandler)
+	mux.HandleFunc("/ready", readiness.ReadyHandler)
+	// /livez и /readyz отдают JSON; /readyz проверяет cache, user и task сервисы
+	mux.HandleFunc("/livez", checker.LivezHandler)
+	mux.HandleFunc("/readyz", checker.ReadyzHandler)
+	// Служебное API (снятие блокировок входа), nil - отключено
+	if admin != nil {
+		mux.Handle("/admin/", admin)
+	}
 
-	// Запуск сервера на порту 8051
-	if err := http.ListenAndServe(":8051", mux); err != nil {
-		log.Fatalf("Failed to start metrics/health server: %v", err)
+	return &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
 	}
 }
 
+// newLimiter создаёт ограничитель запросов; в режиме off возвращает nil
+func newLimiter(cfg config.RateLimit, cache *grpccache.CacheClient, logger *slog.Logger) (*ratelimit.Limiter, error) {
+	policies, err := ratelimit.ParsePolicies(cfg.Policies)
+	if err != nil {
+		return nil, err
+	}
+	var store ratelimit.Store
+	switch cfg.Mode {
+	case "off":
+		return nil, nil
+	case "local":
+		store = ratelimit.NewMemoryStore()
+	default:
+		store = ratelimit.NewCacheStore(cache)
+	}
+	return ratelimit.New(store, policies, logger), nil
+}
+
+// fatal пишет ошибку в лог и завершает процесс
+func fatal(msg string, err error) {
+	slog.Error(msg, slog.Any("error", err))
+	os.Exit(1)
+}
+
 func main() {
-	time.Sleep(time.Second * 3)
-	// Запуск сервера метрик и health checks в отдельной горутине
-	go startMetricsAndHealthServer()
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		fatal("Invalid configuration", err)
+	}
+	if cfg.PrintOnly {
+		if err := cfg.Print(os.Stdout); err != nil {
+			fatal("Error printing configuration", err)
+		}
+		return
+	}
+
+	logger, err := logging.New(os.Stdout, cfg.Log.Level, cfg.Log.Format)
+	if err != nil {
+		fatal("Invalid log settings", err)
+	}
+	slog.SetDefault(logger)
 
-	cache_service, err := grpccache.NewCacheClient("cache_service:50053")
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+
+	shutdownTracing, err := sharedtracing.Setup(ctx, "api_service", sharedtracing.Options{
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.Endpoint,
+		File:        cfg.Tracing.File,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
 	if err != nil {
-		log.Fatal(err)
+		fatal("Error setting up tracing", err)
 	}
-	defer cache_service.Close()
-	authHandler, err := handlers.NewUserAuthHandler("user_service:50051", cache_service)
+
+	// Соединения не блокируют старт: зависимости могут подняться позже,
+	// до этого /readyz отвечает 503
+	grpcMetrics := grpcmw.NewMetrics()
+	prometheus.MustRegister(grpcMetrics)
+	dialOpts := grpcconn.Options(
+		grpcconn.Backoff{Base: cfg.Services.BackoffBase, Max: cfg.Services.BackoffMax},
+		grpcMetrics, logger,
+	)
+	cache_service, err := grpccache.NewCacheClient(cfg.Services.Cache, dialOpts...)
 	if err != nil {
-		log.Fatal(err)
+		fatal("Error creating cache_service client", err)
 	}
-	defer authHandler.Close()
-	taskHandler, err := handlers.NewTaskServiceClient("task_service:50052", cache_service)
+	keys, err := auth.ParseKeySet(cfg.Auth.ActiveKID, cfg.Auth.Keys)
 	if err != nil {
-		log.Fatal(err)
+		fatal("Error parsing signing keys", err)
 	}
-	defer taskHandler.Close()
+	tokens := auth.NewTokenIssuer(keys, cfg.Auth.Issuer, cfg.Auth.AccessTTL, cfg.Auth.RefreshTTL)
+	authn := auth.NewAuthenticator(
+		auth.NewVerifier(keys, cfg.Auth.Issuer, cfg.Auth.Leeway),
+		cache_service,
+		cfg.Auth.SessionCacheTTL,
+	)
+	// Приём токена из cookie включается явно, чтобы не открывать CSRF для обычных клиентов
+	if cfg.Auth.TokenCookie {
+		authn.TokenCookie = "access_token"
+	}
+	limiter, err := newLimiter(cfg.RateLimit, cache_service, logger)
+	if err != nil {
+		fatal("Invalid rate limit settings", err)
+	}
+	authHandler, err := handlers.NewUserAuthHandler(cfg.Services.User, cache_service, tokens, authn, dialOpts...)
+	if err != nil {
+		fatal("Error creating user_service client", err)
+	}
+	authHandler.Limits = limiter
+	cursors := pagination.NewSigner([]byte(cfg.Pagination.CursorSecret))
+	taskHandler, err := handlers.NewTaskServiceClient(cfg.Services.Task, authn, cursors, dialOpts...)
+	if err != nil {
+		fatal("Error creating task_service client", err)
+	}
+	taskHandler.Limits = limiter
+
+	readiness := health.NewReadiness()
+	checker := health.NewChecker(readiness, cfg.Health.Timeout, cfg.Health.CacheTTL,
+		health.Check{Name: "cache_service", Check: health.GRPC(cache_service.Conn(), "")},
+		health.Check{Name: "user_service", Check: health.GRPC(authHandler.Client.Conn(), "")},
+		health.Check{Name: "task_service", Check: health.GRPC(taskHandler.Client.Conn(), "")},
+	)
+	var admin http.Handler
+	if cfg.Admin.Token != "" {
+		admin = authHandler.AdminRoutes(cfg.Admin.Token)
+	}
+	metricsServer := newMetricsAndHealthServer(cfg.HTTP.MetricsAddr, readiness, checker, admin)
+	// Запуск сервера метрик и health checks в отдельной горутине
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fatal("Failed to start metrics/health server", err)
+		}
+	}()
 
 	r := chi.NewRouter()
+	r.Use(httplog.RequestID)
+	r.Use(clientip.Middleware(cfg.HTTP.TrustForwardedFor))
+	r.Use(httplog.AccessLog(cfg.Log.Access, logger, os.Stdout))
+	r.Use(metrics.Middleware)
+	r.Use(tracing.RouteMiddleware)
 	r.Group(func(r chi.Router) {
 		authHandler.RegisterRoutes(r)
 	})
@@ -92,9 +182,61 @@ func main() {
 		taskHandler.RegisterRoutes(r)
 	})
 
-	log.Println("Server starting on 3723:3723")
-	if err := http.ListenAndServe(":3723", r); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	server := &http.Server{
+		Addr:              cfg.HTTP.Addr,
+		Handler:           tracing.Handler(r),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	serverErr := make(chan error, 1)
+	go func() {
+		logger.Info("Server starting", slog.String("addr", cfg.HTTP.Addr))
+		serverErr <- server.ListenAndServe()
+	}()
+
+	exitCode := 0
+	select {
+	case <-ctx.Done():
+		logger.Info("Shutting down server")
+		// Балансировщик видит 503 на /ready и перестаёт слать новые запросы
+		readiness.SetDraining()
+		stop() // повторный сигнал завершит процесс сразу
+		time.Sleep(cfg.Shutdown.DrainPeriod)
+	case err := <-serverErr:
+		logger.Error("Failed to start server", slog.Any("error", err))
+		readiness.SetDraining()
+		stop()
+		exitCode = 1
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Shutdown.Timeout)
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		logger.Error("Error shutting down server", slog.Any("error", err))
+		exitCode = 1
+	}
+
+	// Клиенты закрываются после завершения всех запросов, в обратном порядке создания
+	clients := []struct {
+		name   string
+		closer io.Closer
+	}{
+		{"task_service", taskHandler},
+		{"user_service", authHandler},
+		{"cache_service", cache_service},
+	}
+	for _, c := range clients {
+		if err := c.closer.Close(); err != nil {
+			logger.Error("Error closing client", slog.String("service", c.name), slog.Any("error", err))
+		}
 	}
 
+	if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+		logger.Error("Error shutting down metrics/health server", slog.Any("error", err))
+	}
+	// Последние спаны отправляются после завершения всех запросов
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		logger.Error("Error flushing traces", slog.Any("error", err))
+	}
+	cancel()
+	logger.Info("Server stopped")
+	os.Exit(exitCode)
 }
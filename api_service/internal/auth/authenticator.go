@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"api_service/internal/grpc/grpc_server"
+	grpccache "api_service/internal/grpc_cache"
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrRevoked     = errors.New("token revoked")
+	ErrUnavailable = errors.New("session store unavailable")
+)
+
+// Authenticator проверяет jwt локально, а в cache_service ходит только за отзывом
+type Authenticator struct {
+	// TokenCookie - имя cookie, из которой также принимается токен; пустое - только заголовок
+	TokenCookie string
+
+	verifier *Verifier
+	cache    *grpccache.CacheClient
+	sessions *sessionCache
+}
+
+// NewAuthenticator: sessionTTL - сколько доверять последнему ответу cache_service (0 - не кешировать)
+func NewAuthenticator(verifier *Verifier, cache *grpccache.CacheClient, sessionTTL time.Duration) *Authenticator {
+	return &Authenticator{
+		verifier: verifier,
+		cache:    cache,
+		sessions: newSessionCache(sessionTTL),
+	}
+}
+
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (Session, error) {
+	// Подпись проверяется до обращения к cache_service: поддельный токен
+	// не доходит до Redis, а недоступный Redis не пропускает запрос
+	claims, err := a.verifier.Verify(token)
+	if err != nil {
+		return Session{}, err
+	}
+	if s, ok := a.sessions.get(token); ok {
+		return s, nil
+	}
+
+	resp, err := a.cache.GetUser(ctx, &grpc_server.GetUserRequest{
+		JwtKey: token,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+			return Session{}, ErrUnavailable
+		}
+		return Session{}, ErrRevoked
+	}
+	if !resp.Success {
+		return Session{}, ErrRevoked
+	}
+	if claims.UserID != 0 && claims.UserID != resp.UserId {
+		return Session{}, ErrInvalidToken
+	}
+
+	s := Session{
+		ID:     resp.SessionId,
+		UserID: resp.UserId,
+		Login:  resp.UserLogin,
+		Claims: claims,
+	}
+	if resp.ExpiresAt > 0 {
+		s.ExpiresAt = time.Unix(resp.ExpiresAt, 0)
+	}
+	a.sessions.put(token, s)
+	return s, nil
+}
+
+// Forget сбрасывает локально запомненную сессию после logout
+func (a *Authenticator) Forget(token string) {
+	a.sessions.forget(token)
+}
+
+func (a *Authenticator) ForgetUser(userID int32) {
+	a.sessions.forgetUser(userID)
+}
@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Коды ошибок из RFC 6750, раздел 3.1
+const (
+	ErrCodeInvalidRequest    = "invalid_request"
+	ErrCodeInvalidToken      = "invalid_token"
+	ErrCodeInsufficientScope = "insufficient_scope"
+)
+
+const realm = "api_service"
+
+var (
+	ErrNoToken          = errors.New("no bearer token")
+	ErrMalformedRequest = errors.New("malformed authorization header")
+)
+
+// TokenFromRequest достаёт токен из "Authorization: Bearer <token>". Если cookie не пустой,
+// токен также принимается из этой cookie (заголовок имеет приоритет).
+func TokenFromRequest(r *http.Request, cookie string) (string, error) {
+	header := r.Header.Get("Authorization")
+	if header != "" {
+		return parseAuthorization(header)
+	}
+	if cookie != "" {
+		if c, err := r.Cookie(cookie); err == nil && c.Value != "" {
+			if !isToken68(c.Value) {
+				return "", ErrMalformedRequest
+			}
+			return c.Value, nil
+		}
+	}
+	return "", ErrNoToken
+}
+
+func parseAuthorization(header string) (string, error) {
+	scheme, token, found := strings.Cut(header, " ")
+	if !found {
+		// Старые клиенты присылают голый jwt без схемы
+		if strings.Count(header, ".") == 2 && isToken68(header) {
+			return header, nil
+		}
+		if strings.EqualFold(header, "Bearer") {
+			return "", ErrMalformedRequest
+		}
+		return "", ErrNoToken
+	}
+	if !strings.EqualFold(scheme, "Bearer") {
+		// Другие схемы (Basic и т.п.) этот ресурс не понимает
+		return "", ErrNoToken
+	}
+	token = strings.TrimLeft(token, " ")
+	if token == "" || !isToken68(token) {
+		return "", ErrMalformedRequest
+	}
+	return token, nil
+}
+
+// isToken68 проверяет синтаксис b64token: 1*( ALPHA / DIGIT / "-" / "." / "_" / "~" / "+" / "/" ) *"="
+func isToken68(s string) bool {
+	s = strings.TrimRight(s, "=")
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case strings.ContainsRune("-._~+/", c):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Challenge выставляет WWW-Authenticate. Пустой code - запрос без токена, по RFC
+// в этом случае код ошибки не сообщается.
+func Challenge(w http.ResponseWriter, code, description, scope string) {
+	params := []string{fmt.Sprintf("realm=%q", realm)}
+	if code != "" {
+		params = append(params, fmt.Sprintf("error=%q", code))
+	}
+	if description != "" {
+		params = append(params, fmt.Sprintf("error_description=%q", description))
+	}
+	if scope != "" {
+		params = append(params, fmt.Sprintf("scope=%q", scope))
+	}
+	w.Header().Set("WWW-Authenticate", "Bearer "+strings.Join(params, ", "))
+}
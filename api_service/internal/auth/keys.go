@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// KeySet - ключи HS256 по kid. Активным ключом подписываются новые токены,
+// остальные принимаются только для проверки (ротация ключей без разлогинивания).
+type KeySet struct {
+	keys   map[string][]byte
+	active string
+}
+
+func NewKeySet(active string, keys map[string]string) (*KeySet, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("empty jwt key set")
+	}
+	ks := &KeySet{keys: make(map[string][]byte, len(keys)), active: active}
+	for kid, secret := range keys {
+		if secret == "" {
+			return nil, fmt.Errorf("empty secret for jwt key %q", kid)
+		}
+		ks.keys[kid] = []byte(secret)
+	}
+	if _, ok := ks.keys[active]; !ok {
+		return nil, fmt.Errorf("active jwt key %q is not in key set", active)
+	}
+	return ks, nil
+}
+
+// ParseKeySet разбирает строку вида "kid1:secret1,kid2:secret2"
+func ParseKeySet(active, spec string) (*KeySet, error) {
+	keys := map[string]string{}
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		kid, secret, ok := strings.Cut(pair, ":")
+		if !ok || kid == "" {
+			return nil, fmt.Errorf("invalid jwt key %q, expected kid:secret", pair)
+		}
+		keys[kid] = secret
+	}
+	return NewKeySet(active, keys)
+}
+
+func (ks *KeySet) signingKey() (string, []byte) {
+	return ks.active, ks.keys[ks.active]
+}
+
+// lookup ищет ключ по kid; токены без kid (их выпускает user_service) проверяются активным ключом
+func (ks *KeySet) lookup(kid string) ([]byte, bool) {
+	if kid == "" {
+		kid = ks.active
+	}
+	key, ok := ks.keys[kid]
+	return key, ok
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+	"strings"
+)
+
+// Principal - аутентифицированный пользователь текущего запроса
+type Principal struct {
+	UserID    int32
+	Login     string
+	SessionID string // ID сессии в cache_service, для старых сессий - jti access токена
+	Scopes    []string
+	// Token - исходный токен, он же ключ сессии в cache_service
+	Token string
+}
+
+// NewPrincipal собирает Principal из подтверждённой сессии
+func NewPrincipal(s Session, token string) *Principal {
+	p := &Principal{
+		UserID:    s.UserID,
+		Login:     s.Login,
+		SessionID: s.ID,
+		Token:     token,
+	}
+	if s.Claims != nil {
+		if p.SessionID == "" {
+			p.SessionID = s.Claims.ID
+		}
+		p.Scopes = strings.Fields(s.Claims.Scope)
+	}
+	return p
+}
+
+// HasScope проверяет, выдан ли токену scope
+func (p *Principal) HasScope(scope string) bool {
+	for _, s := range p.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// principalKey - неэкспортируемый тип ключа, чтобы не пересекаться с другими пакетами
+type principalKey struct{}
+
+// WithPrincipal кладёт Principal в контекст
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext достаёт Principal, положенный AuthMiddleware
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
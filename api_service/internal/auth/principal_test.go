@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrincipalContext(t *testing.T) {
+	_, ok := FromContext(context.Background())
+	assert.False(t, ok)
+
+	_, ok = FromContext(WithPrincipal(context.Background(), nil))
+	assert.False(t, ok)
+
+	session := Session{
+		UserID: 7,
+		Login:  "alice",
+		Claims: &Claims{
+			RegisteredClaims: jwt.RegisteredClaims{ID: "jti-1"},
+			Scope:            "tasks:read admin",
+		},
+	}
+	ctx := WithPrincipal(context.Background(), NewPrincipal(session, "token"))
+
+	p, ok := FromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, int32(7), p.UserID)
+	assert.Equal(t, "alice", p.Login)
+	assert.Equal(t, "jti-1", p.SessionID)
+	assert.Equal(t, "token", p.Token)
+	assert.True(t, p.HasScope("admin"))
+	assert.False(t, p.HasScope("tasks:write"))
+
+	// ID сессии из cache_service важнее jti
+	session.ID = "sess-1"
+	assert.Equal(t, "sess-1", NewPrincipal(session, "token").SessionID)
+}
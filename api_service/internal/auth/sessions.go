@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"sync"
+	"time"
+)
+
+// Session - подтверждённая cache_service сессия
+type Session struct {
+	// ID - идентификатор сессии в cache_service, пуст у старых записей
+	ID     string
+	UserID int32
+	Login  string
+	Claims *Claims
+	// ExpiresAt - когда сессия истечёт в cache_service, нулевое - неизвестно
+	ExpiresAt time.Time
+}
+
+type sessionEntry struct {
+	session Session
+	expires time.Time
+}
+
+// sessionCache ненадолго запоминает ответы cache_service, чтобы горячие запросы
+// не делали лишний gRPC вызов. Отзыв токена на других репликах виден не позже ttl.
+type sessionCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]sessionEntry
+}
+
+const maxSessionCacheSize = 10000
+
+func newSessionCache(ttl time.Duration) *sessionCache {
+	return &sessionCache{
+		ttl:     ttl,
+		entries: make(map[string]sessionEntry),
+	}
+}
+
+func (c *sessionCache) get(token string) (Session, bool) {
+	if c.ttl <= 0 {
+		return Session{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[token]
+	if !ok {
+		return Session{}, false
+	}
+	if time.Now().After(e.expires) {
+		delete(c.entries, token)
+		return Session{}, false
+	}
+	return e.session, true
+}
+
+func (c *sessionCache) put(token string, s Session) {
+	if c.ttl <= 0 {
+		return
+	}
+	expires := time.Now().Add(c.ttl)
+	// Запись не должна пережить сам токен
+	if s.Claims != nil && s.Claims.ExpiresAt != nil && s.Claims.ExpiresAt.Before(expires) {
+		expires = s.Claims.ExpiresAt.Time
+	}
+	if !s.ExpiresAt.IsZero() && s.ExpiresAt.Before(expires) {
+		expires = s.ExpiresAt
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= maxSessionCacheSize {
+		c.evictExpired()
+	}
+	if len(c.entries) >= maxSessionCacheSize {
+		return
+	}
+	c.entries[token] = sessionEntry{session: s, expires: expires}
+}
+
+func (c *sessionCache) evictExpired() {
+	now := time.Now()
+	for token, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, token)
+		}
+	}
+}
+
+func (c *sessionCache) forget(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, token)
+}
+
+func (c *sessionCache) forgetUser(userID int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for token, e := range c.entries {
+		if e.session.UserID == userID {
+			delete(c.entries, token)
+		}
+	}
+}
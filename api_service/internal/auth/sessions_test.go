@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionCacheExpiry(t *testing.T) {
+	c := newSessionCache(time.Minute)
+
+	c.put("live", Session{UserID: 1, ExpiresAt: time.Now().Add(time.Hour)})
+	_, ok := c.get("live")
+	assert.True(t, ok)
+
+	// Сессия, которая в cache_service уже истекла, не запоминается дольше неё
+	c.put("expired", Session{UserID: 1, ExpiresAt: time.Now().Add(-time.Second)})
+	_, ok = c.get("expired")
+	assert.False(t, ok)
+
+	c.forgetUser(1)
+	_, ok = c.get("live")
+	assert.False(t, ok)
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// TokenIssuer выпускает короткоживущие access токены (HS256) и refresh токены
+type TokenIssuer struct {
+	keys       *KeySet
+	issuer     string
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+}
+
+func NewTokenIssuer(keys *KeySet, issuer string, accessTTL, refreshTTL time.Duration) *TokenIssuer {
+	return &TokenIssuer{
+		keys:       keys,
+		issuer:     issuer,
+		AccessTTL:  accessTTL,
+		RefreshTTL: refreshTTL,
+	}
+}
+
+// AccessToken подписывает jwt активным ключом из KeySet
+func (i *TokenIssuer) AccessToken(userID int32, login string) (string, error) {
+	jti, err := randomToken(16)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    i.issuer,
+			Subject:   login,
+			ID:        jti,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(i.AccessTTL)),
+		},
+		UserID: userID,
+	})
+	kid, key := i.keys.signingKey()
+	token.Header["kid"] = kid
+	return token.SignedString(key)
+}
+
+// RefreshToken возвращает непрозрачный случайный refresh токен
+func (i *TokenIssuer) RefreshToken() (string, error) {
+	return randomToken(32)
+}
+
+// FamilyID возвращает идентификатор нового семейства refresh токенов
+func (i *TokenIssuer) FamilyID() (string, error) {
+	return randomToken(16)
+}
+
+func randomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("Error to generate token: %s", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
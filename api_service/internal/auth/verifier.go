@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// Claims - claims access токена. uid выставляют и user_service, и api_service;
+// scope - список через пробел (RFC 8693), у токенов без него scope нет.
+type Claims struct {
+	jwt.RegisteredClaims
+	UserID int32  `json:"uid,omitempty"`
+	Scope  string `json:"scope,omitempty"`
+}
+
+// Verifier локально проверяет подпись и сроки действия jwt
+type Verifier struct {
+	keys   *KeySet
+	parser *jwt.Parser
+}
+
+// NewVerifier: пустой issuer отключает проверку iss, leeway - допуск на расхождение часов
+func NewVerifier(keys *KeySet, issuer string, leeway time.Duration) *Verifier {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(leeway),
+	}
+	if issuer != "" {
+		opts = append(opts, jwt.WithIssuer(issuer))
+	}
+	return &Verifier{
+		keys:   keys,
+		parser: jwt.NewParser(opts...),
+	}
+}
+
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := v.parser.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, ok := v.keys.lookup(kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		return key, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing sub", ErrInvalidToken)
+	}
+	return claims, nil
+}
@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sign(t *testing.T, kid, secret string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString([]byte(secret))
+	require.NoError(t, err)
+	return s
+}
+
+func TestVerifier(t *testing.T) {
+	keys, err := ParseKeySet("new", "new:new-secret,old:old-secret")
+	require.NoError(t, err)
+	v := NewVerifier(keys, "case_champion", time.Second)
+	exp := time.Now().Add(time.Hour).Unix()
+
+	t.Run("issued token", func(t *testing.T) {
+		issuer := NewTokenIssuer(keys, "case_champion", time.Minute, time.Hour)
+		token, err := issuer.AccessToken(7, "user")
+		require.NoError(t, err)
+
+		claims, err := v.Verify(token)
+		assert.NoError(t, err)
+		assert.Equal(t, "user", claims.Subject)
+		assert.Equal(t, int32(7), claims.UserID)
+	})
+
+	t.Run("token without kid uses active key", func(t *testing.T) {
+		token := sign(t, "", "new-secret", jwt.MapClaims{"sub": "user", "iss": "case_champion", "exp": exp})
+		_, err := v.Verify(token)
+		assert.NoError(t, err)
+	})
+
+	t.Run("rotated key", func(t *testing.T) {
+		token := sign(t, "old", "old-secret", jwt.MapClaims{"sub": "user", "iss": "case_champion", "exp": exp})
+		_, err := v.Verify(token)
+		assert.NoError(t, err)
+	})
+
+	t.Run("forged signature", func(t *testing.T) {
+		token := sign(t, "new", "guessed", jwt.MapClaims{"sub": "user", "iss": "case_champion", "exp": exp})
+		_, err := v.Verify(token)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("unknown kid", func(t *testing.T) {
+		token := sign(t, "other", "new-secret", jwt.MapClaims{"sub": "user", "iss": "case_champion", "exp": exp})
+		_, err := v.Verify(token)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		token := sign(t, "", "new-secret", jwt.MapClaims{"sub": "user", "iss": "case_champion", "exp": time.Now().Add(-time.Minute).Unix()})
+		_, err := v.Verify(token)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("not yet valid", func(t *testing.T) {
+		token := sign(t, "", "new-secret", jwt.MapClaims{"sub": "user", "iss": "case_champion", "exp": exp, "nbf": time.Now().Add(time.Minute).Unix()})
+		_, err := v.Verify(token)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("missing exp", func(t *testing.T) {
+		token := sign(t, "", "new-secret", jwt.MapClaims{"sub": "user", "iss": "case_champion"})
+		_, err := v.Verify(token)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("wrong issuer", func(t *testing.T) {
+		token := sign(t, "", "new-secret", jwt.MapClaims{"sub": "user", "iss": "someone", "exp": exp})
+		_, err := v.Verify(token)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("alg none", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{"sub": "user", "iss": "case_champion", "exp": exp})
+		s, err := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+		require.NoError(t, err)
+		_, err = v.Verify(s)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
+
+func TestParseKeySet(t *testing.T) {
+	_, err := ParseKeySet("a", "a:secret,b")
+	assert.Error(t, err)
+
+	_, err = ParseKeySet("c", "a:secret")
+	assert.Error(t, err)
+
+	_, err = ParseKeySet("a", "a:")
+	assert.Error(t, err)
+}
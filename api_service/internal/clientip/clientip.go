@@ -0,0 +1,57 @@
+// Package clientip определяет адрес клиента один раз на запрос: по нему
+// работают rate limit, блокировка входа и access log
+package clientip
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+type clientIPKey struct{}
+
+// Middleware кладёт адрес клиента в контекст. С trustForwardedFor берётся
+// последний адрес X-Forwarded-For: его дописал наш прокси, а предыдущие
+// может подделать сам клиент.
+func Middleware(trustForwardedFor bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := ""
+			if trustForwardedFor {
+				ip = lastForwardedFor(r)
+			}
+			if ip == "" {
+				ip = remoteHost(r.RemoteAddr)
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientIPKey{}, ip)))
+		})
+	}
+}
+
+// FromRequest возвращает адрес из Middleware, а без неё - адрес соединения
+func FromRequest(r *http.Request) string {
+	if ip, ok := r.Context().Value(clientIPKey{}).(string); ok {
+		return ip
+	}
+	return remoteHost(r.RemoteAddr)
+}
+
+func lastForwardedFor(r *http.Request) string {
+	values := r.Header.Values("X-Forwarded-For")
+	if len(values) == 0 {
+		return ""
+	}
+	last := values[len(values)-1]
+	if i := strings.LastIndex(last, ","); i >= 0 {
+		last = last[i+1:]
+	}
+	return strings.TrimSpace(last)
+}
+
+func remoteHost(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
@@ -0,0 +1,38 @@
+package clientip
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func resolve(trust bool, r *http.Request) string {
+	var got string
+	Middleware(trust)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = FromRequest(r)
+	})).ServeHTTP(httptest.NewRecorder(), r)
+	return got
+}
+
+func TestMiddleware(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Add("X-Forwarded-For", "10.0.0.1, 10.0.0.2")
+	r.Header.Add("X-Forwarded-For", "203.0.113.9")
+
+	assert.Equal(t, "192.0.2.1", resolve(false, r))
+	assert.Equal(t, "203.0.113.9", resolve(true, r))
+
+	r.Header.Set("X-Forwarded-For", "10.0.0.1, 10.0.0.2")
+	assert.Equal(t, "10.0.0.2", resolve(true, r))
+
+	r.Header.Del("X-Forwarded-For")
+	assert.Equal(t, "192.0.2.1", resolve(true, r))
+}
+
+func TestFromRequestWithoutMiddleware(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.RemoteAddr = "[2001:db8::1]:443"
+	assert.Equal(t, "2001:db8::1", FromRequest(r))
+}
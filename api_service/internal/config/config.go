@@ -0,0 +1,287 @@
+// Package config собирает настройки api_service из значений по умолчанию,
+// YAML файла, переменных окружения и флагов (в порядке возрастания приоритета)
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"platform/configload"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+type HTTP struct {
+	// Addr - публичный API
+	Addr string `yaml:"addr"`
+	// MetricsAddr - /metrics и /health
+	MetricsAddr string `yaml:"metrics_addr"`
+	// TrustForwardedFor - брать IP клиента из X-Forwarded-For, только за своим прокси
+	TrustForwardedFor bool `yaml:"trust_forwarded_for"`
+}
+
+// Services - адреса gRPC зависимостей
+type Services struct {
+	Cache string `yaml:"cache"`
+	User  string `yaml:"user"`
+	Task  string `yaml:"task"`
+	// BackoffBase и BackoffMax - задержки между попытками переподключения
+	BackoffBase time.Duration `yaml:"backoff_base"`
+	BackoffMax  time.Duration `yaml:"backoff_max"`
+}
+
+type Auth struct {
+	// Secret - общий с user_service SECRET_KEY, ключ по умолчанию для Keys и CursorSecret
+	Secret string `yaml:"secret"`
+	// Keys - "kid1:secret1,kid2:secret2", подписывает ActiveKID
+	Keys       string        `yaml:"keys"`
+	ActiveKID  string        `yaml:"active_kid"`
+	Issuer     string        `yaml:"issuer"`
+	AccessTTL  time.Duration `yaml:"access_ttl"`
+	RefreshTTL time.Duration `yaml:"refresh_ttl"`
+	Leeway     time.Duration `yaml:"leeway"`
+	// SessionCacheTTL - сколько реплика помнит проверенную сессию. Отозванный на
+	// другой реплике токен принимается до истечения этого срока, поэтому по
+	// умолчанию кэш выключен.
+	SessionCacheTTL time.Duration `yaml:"session_cache_ttl"`
+	// TokenCookie разрешает передавать токен в cookie access_token
+	TokenCookie bool `yaml:"token_cookie"`
+}
+
+// Shutdown - параметры остановки по SIGTERM/SIGINT
+type Shutdown struct {
+	// DrainPeriod - сколько /ready отвечает 503 до закрытия listener'а
+	DrainPeriod time.Duration `yaml:"drain_period"`
+	// Timeout - ограничение на завершение активных запросов
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// Health - проверки зависимостей для /readyz
+type Health struct {
+	// Timeout - ограничение на одну проверку всех зависимостей
+	Timeout time.Duration `yaml:"timeout"`
+	// CacheTTL - сколько переиспользуется последний результат
+	CacheTTL time.Duration `yaml:"cache_ttl"`
+}
+
+// Tracing - экспорт спанов OpenTelemetry
+type Tracing struct {
+	// Exporter - none, otlp (gRPC на Endpoint), stdout или file (JSON в File)
+	Exporter string `yaml:"exporter"`
+	Endpoint string `yaml:"endpoint"`
+	File     string `yaml:"file"`
+	// SampleRatio - доля новых трасс, которые записываются (0..1)
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+// Log - логи сервиса и access log
+type Log struct {
+	// Level - debug, info, warn или error
+	Level string `yaml:"level"`
+	// Format - json или text
+	Format string `yaml:"format"`
+	// Access - формат access log: json, common (Common Log Format) или off
+	Access string `yaml:"access"`
+}
+
+// RateLimit - ограничение частоты запросов по пользователю или IP
+type RateLimit struct {
+	// Mode - off, local (бакеты в памяти реплики) или redis (общие бакеты в cache_service)
+	Mode string `yaml:"mode"`
+	// Policies - "имя=запросов/период" через запятую для login, register, refresh, search и default
+	Policies string `yaml:"policies"`
+}
+
+// Admin - служебное API на порту метрик
+type Admin struct {
+	// Token - bearer токен для /admin/*, пустой отключает служебное API
+	Token string `yaml:"token"`
+}
+
+type Pagination struct {
+	// CursorSecret подписывает курсоры, должен совпадать на всех репликах
+	CursorSecret string `yaml:"cursor_secret"`
+}
+
+type Config struct {
+	HTTP       HTTP       `yaml:"http"`
+	Services   Services   `yaml:"services"`
+	Auth       Auth       `yaml:"auth"`
+	Pagination Pagination `yaml:"pagination"`
+	Shutdown   Shutdown   `yaml:"shutdown"`
+	Health     Health     `yaml:"health"`
+	Tracing    Tracing    `yaml:"tracing"`
+	Log        Log        `yaml:"log"`
+	RateLimit  RateLimit  `yaml:"rate_limit"`
+	Admin      Admin      `yaml:"admin"`
+
+	// PrintOnly - запрошен --print-config: вывести конфигурацию и выйти
+	PrintOnly bool `yaml:"-"`
+}
+
+// Default - значения для docker-compose
+func Default() *Config {
+	return &Config{
+		HTTP: HTTP{
+			Addr:        ":3723",
+			MetricsAddr: ":8051",
+		},
+		Services: Services{
+			Cache:       "cache_service:50053",
+			User:        "user_service:50051",
+			Task:        "task_service:50052",
+			BackoffBase: 250 * time.Millisecond,
+			BackoffMax:  5 * time.Second,
+		},
+		Auth: Auth{
+			Secret:     "default-secret-key",
+			ActiveKID:  "default",
+			AccessTTL:  15 * time.Minute,
+			RefreshTTL: 30 * 24 * time.Hour,
+			Leeway:     30 * time.Second,
+		},
+		Shutdown: Shutdown{
+			DrainPeriod: 5 * time.Second,
+			Timeout:     15 * time.Second,
+		},
+		Health: Health{
+			Timeout:  2 * time.Second,
+			CacheTTL: 5 * time.Second,
+		},
+		Tracing: Tracing{
+			Exporter:    "none",
+			SampleRatio: 1,
+		},
+		Log: Log{
+			Level:  "info",
+			Format: "json",
+			Access: "json",
+		},
+		RateLimit: RateLimit{
+			Mode:     "redis",
+			Policies: "login=10/1m,register=5/1m,refresh=30/1m,search=30/1m,default=300/1m",
+		},
+	}
+}
+
+// applyDerived заполняет значения, которые по умолчанию выводятся из других
+func (c *Config) applyDerived() {
+	if c.Auth.Keys == "" {
+		c.Auth.Keys = c.Auth.ActiveKID + ":" + c.Auth.Secret
+	}
+	if c.Pagination.CursorSecret == "" {
+		c.Pagination.CursorSecret = c.Auth.Secret
+	}
+}
+
+// Validate проверяет конфигурацию и возвращает все ошибки сразу
+func (c *Config) Validate() error {
+	var errs []error
+	required := map[string]string{
+		"http.addr":                c.HTTP.Addr,
+		"http.metrics_addr":        c.HTTP.MetricsAddr,
+		"services.cache":           c.Services.Cache,
+		"services.user":            c.Services.User,
+		"services.task":            c.Services.Task,
+		"auth.keys":                c.Auth.Keys,
+		"auth.active_kid":          c.Auth.ActiveKID,
+		"pagination.cursor_secret": c.Pagination.CursorSecret,
+	}
+	for _, name := range sortedKeys(required) {
+		if required[name] == "" {
+			errs = append(errs, fmt.Errorf("%s is required", name))
+		}
+	}
+	if c.Services.BackoffBase <= 0 {
+		errs = append(errs, errors.New("services.backoff_base must be positive"))
+	}
+	if c.Services.BackoffMax < c.Services.BackoffBase {
+		errs = append(errs, errors.New("services.backoff_max must not be less than services.backoff_base"))
+	}
+	if c.Auth.AccessTTL <= 0 {
+		errs = append(errs, errors.New("auth.access_ttl must be positive"))
+	}
+	if c.Auth.RefreshTTL <= c.Auth.AccessTTL {
+		errs = append(errs, errors.New("auth.refresh_ttl must be longer than auth.access_ttl"))
+	}
+	if c.Auth.Leeway < 0 {
+		errs = append(errs, errors.New("auth.leeway must not be negative"))
+	}
+	if c.Auth.SessionCacheTTL < 0 {
+		errs = append(errs, errors.New("auth.session_cache_ttl must not be negative"))
+	}
+	if c.Shutdown.DrainPeriod < 0 {
+		errs = append(errs, errors.New("shutdown.drain_period must not be negative"))
+	}
+	if c.Shutdown.Timeout <= 0 {
+		errs = append(errs, errors.New("shutdown.timeout must be positive"))
+	}
+	if c.Health.Timeout <= 0 {
+		errs = append(errs, errors.New("health.timeout must be positive"))
+	}
+	if c.Health.CacheTTL < 0 {
+		errs = append(errs, errors.New("health.cache_ttl must not be negative"))
+	}
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		if c.Tracing.Endpoint == "" {
+			errs = append(errs, errors.New("tracing.endpoint is required for the otlp exporter"))
+		}
+	case "file":
+		if c.Tracing.File == "" {
+			errs = append(errs, errors.New("tracing.file is required for the file exporter"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("tracing.exporter must be none, otlp, stdout or file, got %q", c.Tracing.Exporter))
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, errors.New("tracing.sample_ratio must be between 0 and 1"))
+	}
+	switch c.Log.Level {
+	case "debug", "info", "warn", "error":
+	default:
+		errs = append(errs, fmt.Errorf("log.level must be debug, info, warn or error, got %q", c.Log.Level))
+	}
+	if c.Log.Format != "json" && c.Log.Format != "text" {
+		errs = append(errs, fmt.Errorf("log.format must be json or text, got %q", c.Log.Format))
+	}
+	switch c.Log.Access {
+	case "json", "common", "off":
+	default:
+		errs = append(errs, fmt.Errorf("log.access must be json, common or off, got %q", c.Log.Access))
+	}
+	switch c.RateLimit.Mode {
+	case "off", "local", "redis":
+	default:
+		errs = append(errs, fmt.Errorf("rate_limit.mode must be off, local or redis, got %q", c.RateLimit.Mode))
+	}
+	return errors.Join(errs...)
+}
+
+// Print выводит конфигурацию в YAML, секреты скрыты
+func (c *Config) Print(w io.Writer) error {
+	redacted := *c
+	redacted.Auth.Secret = configload.Redact(c.Auth.Secret)
+	redacted.Auth.Keys = configload.Redact(c.Auth.Keys)
+	redacted.Pagination.CursorSecret = configload.Redact(c.Pagination.CursorSecret)
+	redacted.Admin.Token = configload.Redact(c.Admin.Token)
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&redacted); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func env(vars map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		v, ok := vars[key]
+		return v, ok
+	}
+}
+
+func TestLoadDefaults(t *testing.T) {
+	cfg, err := load(nil, env(nil), io.Discard)
+	require.NoError(t, err)
+
+	assert.Equal(t, "cache_service:50053", cfg.Services.Cache)
+	assert.Equal(t, ":3723", cfg.HTTP.Addr)
+	assert.Equal(t, "default:default-secret-key", cfg.Auth.Keys)
+	assert.Equal(t, "default-secret-key", cfg.Pagination.CursorSecret)
+	assert.Equal(t, 15*time.Minute, cfg.Auth.AccessTTL)
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+http:
+  addr: ":9000"
+services:
+  cache: "file-cache:1"
+  user: "file-user:1"
+auth:
+  access_ttl: 5m
+`), 0o600))
+
+	cfg, err := load(
+		[]string{"--config", path, "--user-addr", "flag-user:3", "--auth-token-cookie"},
+		env(map[string]string{"USER_SERVICE_ADDR": "env-user:2", "CACHE_SERVICE_ADDR": "env-cache:2"}),
+		io.Discard,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, ":9000", cfg.HTTP.Addr)
+	assert.Equal(t, "env-cache:2", cfg.Services.Cache)
+	assert.Equal(t, "flag-user:3", cfg.Services.User)
+	assert.Equal(t, "task_service:50052", cfg.Services.Task)
+	assert.Equal(t, 5*time.Minute, cfg.Auth.AccessTTL)
+	assert.True(t, cfg.Auth.TokenCookie)
+}
+
+func TestLoadConfigFileFromEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("services:\n  task: \"other:1\"\n"), 0o600))
+
+	cfg, err := load(nil, env(map[string]string{"CONFIG_FILE": path}), io.Discard)
+	require.NoError(t, err)
+	assert.Equal(t, "other:1", cfg.Services.Task)
+}
+
+func TestLoadErrors(t *testing.T) {
+	_, err := load(nil, env(map[string]string{"ACCESS_TOKEN_TTL": "soon"}), io.Discard)
+	assert.ErrorContains(t, err, "ACCESS_TOKEN_TTL")
+
+	_, err = load([]string{"--task-addr", "", "--access-token-ttl", "0s"}, env(nil), io.Discard)
+	assert.ErrorContains(t, err, "services.task is required")
+	assert.ErrorContains(t, err, "auth.access_ttl must be positive")
+
+	_, err = load(nil, env(map[string]string{"LOG_LEVEL": "trace", "ACCESS_LOG": "combined"}), io.Discard)
+	assert.ErrorContains(t, err, "log.level")
+	assert.ErrorContains(t, err, "log.access")
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("servises:\n  task: x\n"), 0o600))
+	_, err = load([]string{"--config", path}, env(nil), io.Discard)
+	assert.ErrorContains(t, err, "servises")
+}
+
+func TestPrintRedactsSecrets(t *testing.T) {
+	cfg, err := load([]string{"--print-config"}, env(map[string]string{"SECRET_KEY": "hunter2", "ADMIN_TOKEN": "opensesame"}), io.Discard)
+	require.NoError(t, err)
+	assert.True(t, cfg.PrintOnly)
+
+	var buf bytes.Buffer
+	require.NoError(t, cfg.Print(&buf))
+	assert.NotContains(t, buf.String(), "hunter2")
+	assert.NotContains(t, buf.String(), "opensesame")
+	assert.Contains(t, buf.String(), "cache: cache_service:50053")
+	assert.Contains(t, buf.String(), "access_ttl: 15m0s")
+}
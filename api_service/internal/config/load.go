@@ -0,0 +1,69 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"platform/configload"
+)
+
+// fields перечисляет все настраиваемые поля
+func (c *Config) fields() []configload.Field {
+	return []configload.Field{
+		{Flag: "http-addr", Env: "HTTP_ADDR", Usage: "public HTTP API address", Value: (*configload.String)(&c.HTTP.Addr)},
+		{Flag: "metrics-addr", Env: "METRICS_ADDR", Usage: "metrics and health server address", Value: (*configload.String)(&c.HTTP.MetricsAddr)},
+		{Flag: "trust-forwarded-for", Env: "TRUST_FORWARDED_FOR", Usage: "take client IP from X-Forwarded-For (behind a trusted proxy only)", Value: (*configload.Bool)(&c.HTTP.TrustForwardedFor)},
+		{Flag: "cache-addr", Env: "CACHE_SERVICE_ADDR", Usage: "cache_service gRPC address", Value: (*configload.String)(&c.Services.Cache)},
+		{Flag: "user-addr", Env: "USER_SERVICE_ADDR", Usage: "user_service gRPC address", Value: (*configload.String)(&c.Services.User)},
+		{Flag: "task-addr", Env: "TASK_SERVICE_ADDR", Usage: "task_service gRPC address", Value: (*configload.String)(&c.Services.Task)},
+		{Flag: "dial-backoff-base", Env: "DIAL_BACKOFF_BASE", Usage: "initial delay between gRPC reconnection attempts", Value: (*configload.Duration)(&c.Services.BackoffBase)},
+		{Flag: "dial-backoff-max", Env: "DIAL_BACKOFF_MAX", Usage: "maximum delay between gRPC reconnection attempts", Value: (*configload.Duration)(&c.Services.BackoffMax)},
+		{Flag: "secret-key", Env: "SECRET_KEY", Usage: "JWT secret shared with user_service", Value: (*configload.String)(&c.Auth.Secret)},
+		{Flag: "jwt-keys", Env: "JWT_KEYS", Usage: `JWT key set "kid1:secret1,kid2:secret2"`, Value: (*configload.String)(&c.Auth.Keys)},
+		{Flag: "jwt-active-kid", Env: "JWT_ACTIVE_KID", Usage: "kid of the signing key", Value: (*configload.String)(&c.Auth.ActiveKID)},
+		{Flag: "jwt-issuer", Env: "JWT_ISSUER", Usage: "expected iss claim, empty disables the check", Value: (*configload.String)(&c.Auth.Issuer)},
+		{Flag: "access-token-ttl", Env: "ACCESS_TOKEN_TTL", Usage: "access token lifetime", Value: (*configload.Duration)(&c.Auth.AccessTTL)},
+		{Flag: "refresh-token-ttl", Env: "REFRESH_TOKEN_TTL", Usage: "refresh token lifetime", Value: (*configload.Duration)(&c.Auth.RefreshTTL)},
+		{Flag: "jwt-leeway", Env: "JWT_LEEWAY", Usage: "allowed clock skew for exp/nbf", Value: (*configload.Duration)(&c.Auth.Leeway)},
+		{Flag: "session-cache-ttl", Env: "SESSION_CACHE_TTL", Usage: "how long verified sessions are memoized, 0 disables", Value: (*configload.Duration)(&c.Auth.SessionCacheTTL)},
+		{Flag: "auth-token-cookie", Env: "AUTH_TOKEN_COOKIE", Usage: "accept the token from the access_token cookie", Value: (*configload.Bool)(&c.Auth.TokenCookie)},
+		{Flag: "cursor-secret", Env: "CURSOR_SECRET", Usage: "pagination cursor signing key, defaults to secret-key", Value: (*configload.String)(&c.Pagination.CursorSecret)},
+		{Flag: "shutdown-drain-period", Env: "SHUTDOWN_DRAIN_PERIOD", Usage: "how long /ready reports 503 before the listener closes", Value: (*configload.Duration)(&c.Shutdown.DrainPeriod)},
+		{Flag: "shutdown-timeout", Env: "SHUTDOWN_TIMEOUT", Usage: "limit for finishing in-flight requests", Value: (*configload.Duration)(&c.Shutdown.Timeout)},
+		{Flag: "health-timeout", Env: "HEALTH_TIMEOUT", Usage: "timeout for dependency checks on /readyz", Value: (*configload.Duration)(&c.Health.Timeout)},
+		{Flag: "health-cache-ttl", Env: "HEALTH_CACHE_TTL", Usage: "how long a /readyz result is reused", Value: (*configload.Duration)(&c.Health.CacheTTL)},
+		{Flag: "tracing-exporter", Env: "TRACING_EXPORTER", Usage: "span exporter: none, otlp, stdout or file", Value: (*configload.String)(&c.Tracing.Exporter)},
+		{Flag: "tracing-endpoint", Env: "TRACING_ENDPOINT", Usage: "OTLP gRPC collector address", Value: (*configload.String)(&c.Tracing.Endpoint)},
+		{Flag: "tracing-file", Env: "TRACING_FILE", Usage: "file for the file exporter", Value: (*configload.String)(&c.Tracing.File)},
+		{Flag: "tracing-sample-ratio", Env: "TRACING_SAMPLE_RATIO", Usage: "fraction of new traces to record", Value: (*configload.Float)(&c.Tracing.SampleRatio)},
+		{Flag: "log-level", Env: "LOG_LEVEL", Usage: "log level: debug, info, warn or error", Value: (*configload.String)(&c.Log.Level)},
+		{Flag: "log-format", Env: "LOG_FORMAT", Usage: "log format: json or text", Value: (*configload.String)(&c.Log.Format)},
+		{Flag: "access-log", Env: "ACCESS_LOG", Usage: "access log format: json, common or off", Value: (*configload.String)(&c.Log.Access)},
+		{Flag: "rate-limit-mode", Env: "RATE_LIMIT_MODE", Usage: "rate limiting: off, local or redis", Value: (*configload.String)(&c.RateLimit.Mode)},
+		{Flag: "rate-limit-policies", Env: "RATE_LIMIT_POLICIES", Usage: "rate limit policies, name=requests/period,...", Value: (*configload.String)(&c.RateLimit.Policies)},
+		{Flag: "admin-token", Env: "ADMIN_TOKEN", Usage: "bearer token for /admin on the metrics server, empty disables it", Value: (*configload.String)(&c.Admin.Token)},
+	}
+}
+
+// Load читает конфигурацию: значения по умолчанию, затем YAML файл (--config или
+// CONFIG_FILE), затем переменные окружения, затем флаги из args
+func Load(args []string) (*Config, error) {
+	return load(args, os.LookupEnv, os.Stderr)
+}
+
+func load(args []string, lookupEnv func(string) (string, bool), output io.Writer) (*Config, error) {
+	cfg, printOnly, err := configload.Load("api_service", args, lookupEnv, output, func() (*Config, []configload.Field) {
+		c := Default()
+		return c, c.fields()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.applyDerived()
+	cfg.PrintOnly = printOnly
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	return cfg, nil
+}
@@ -2,13 +2,21 @@ package grpccache
 
 import (
 	"api_service/internal/grpc/grpc_server"
+	"api_service/internal/metrics"
 	"context"
 	"fmt"
-	"time"
 
 	"google.golang.org/grpc"
+	_ "google.golang.org/grpc/health" // клиентская проверка grpc.health.v1
 )
 
+// serviceConfig включает клиентскую проверку здоровья: узел cache_service с
+// недоступным Redis (NOT_SERVING) не получает запросов
+const serviceConfig = `{
+	"loadBalancingConfig": [{"round_robin": {}}],
+	"healthCheckConfig": {"serviceName": "cache_service.CacheService"}
+}`
+
 type CacheClient struct {
 	grpc_server.UnimplementedCacheServiceServer
 	Client grpc_server.CacheServiceClient
@@ -18,32 +26,122 @@ type CacheClient struct {
 func (c *CacheClient) DeleteUser(ctx context.Context, req *grpc_server.DeleteUserRequest) (
 	*grpc_server.DeleteUserResponse, error,
 ) {
-	return c.Client.DeleteUser(ctx, req)
+	resp, err := c.Client.DeleteUser(ctx, req)
+	metrics.CacheOperation("DeleteUser", err)
+	return resp, err
+}
+
+func (c *CacheClient) DeleteUserSessions(ctx context.Context, req *grpc_server.DeleteUserSessionsRequest) (
+	*grpc_server.DeleteUserSessionsResponse, error,
+) {
+	resp, err := c.Client.DeleteUserSessions(ctx, req)
+	metrics.CacheOperation("DeleteUserSessions", err)
+	return resp, err
 }
 
 func (c *CacheClient) GetUser(ctx context.Context, req *grpc_server.GetUserRequest) (
 	*grpc_server.GetUserResponse, error,
 ) {
-	return c.Client.GetUser(ctx, req)
+	resp, err := c.Client.GetUser(ctx, req)
+	metrics.CacheOperation("GetUser", err)
+	return resp, err
 }
 
 func (c *CacheClient) Write(ctx context.Context, req *grpc_server.WriteRequest) (
 	*grpc_server.WriteResponse, error,
 ) {
-	return c.Client.Write(ctx, req)
+	resp, err := c.Client.Write(ctx, req)
+	metrics.CacheOperation("Write", err)
+	return resp, err
+}
+
+func (c *CacheClient) WriteRefreshToken(ctx context.Context, req *grpc_server.WriteRefreshTokenRequest) (
+	*grpc_server.WriteRefreshTokenResponse, error,
+) {
+	resp, err := c.Client.WriteRefreshToken(ctx, req)
+	metrics.CacheOperation("WriteRefreshToken", err)
+	return resp, err
+}
+
+func (c *CacheClient) RotateRefreshToken(ctx context.Context, req *grpc_server.RotateRefreshTokenRequest) (
+	*grpc_server.RotateRefreshTokenResponse, error,
+) {
+	resp, err := c.Client.RotateRefreshToken(ctx, req)
+	metrics.CacheOperation("RotateRefreshToken", err)
+	return resp, err
+}
+
+func (c *CacheClient) RateLimit(ctx context.Context, req *grpc_server.RateLimitRequest) (
+	*grpc_server.RateLimitResponse, error,
+) {
+	resp, err := c.Client.RateLimit(ctx, req)
+	metrics.CacheOperation("RateLimit", err)
+	return resp, err
+}
+
+func (c *CacheClient) CheckLogin(ctx context.Context, req *grpc_server.LoginAttemptRequest) (
+	*grpc_server.LoginAttemptResponse, error,
+) {
+	resp, err := c.Client.CheckLogin(ctx, req)
+	metrics.CacheOperation("CheckLogin", err)
+	return resp, err
+}
+
+func (c *CacheClient) LoginFailed(ctx context.Context, req *grpc_server.LoginAttemptRequest) (
+	*grpc_server.LoginAttemptResponse, error,
+) {
+	resp, err := c.Client.LoginFailed(ctx, req)
+	metrics.CacheOperation("LoginFailed", err)
+	return resp, err
+}
+
+func (c *CacheClient) LoginSucceeded(ctx context.Context, req *grpc_server.LoginAttemptRequest) (
+	*grpc_server.LoginAttemptResponse, error,
+) {
+	resp, err := c.Client.LoginSucceeded(ctx, req)
+	metrics.CacheOperation("LoginSucceeded", err)
+	return resp, err
+}
+
+func (c *CacheClient) ClearLoginLock(ctx context.Context, req *grpc_server.ClearLoginLockRequest) (
+	*grpc_server.ClearLoginLockResponse, error,
+) {
+	resp, err := c.Client.ClearLoginLock(ctx, req)
+	metrics.CacheOperation("ClearLoginLock", err)
+	return resp, err
+}
+
+func (c *CacheClient) ListSessions(ctx context.Context, req *grpc_server.ListSessionsRequest) (
+	*grpc_server.ListSessionsResponse, error,
+) {
+	resp, err := c.Client.ListSessions(ctx, req)
+	metrics.CacheOperation("ListSessions", err)
+	return resp, err
+}
+
+func (c *CacheClient) RevokeSession(ctx context.Context, req *grpc_server.RevokeSessionRequest) (
+	*grpc_server.RevokeSessionResponse, error,
+) {
+	resp, err := c.Client.RevokeSession(ctx, req)
+	metrics.CacheOperation("RevokeSession", err)
+	return resp, err
+}
+
+// Conn - соединение для проверок grpc.health.v1
+func (c *CacheClient) Conn() *grpc.ClientConn {
+	return c.conn
 }
 
 func (c *CacheClient) Close() error {
 	return c.conn.Close()
 }
 
-func NewCacheClient(addr string) (*CacheClient, error) {
-	conn, err := grpc.Dial(
-		addr,
+func NewCacheClient(addr string, opts ...grpc.DialOption) (*CacheClient, error) {
+	opts = append(opts,
 		grpc.WithInsecure(),
-		grpc.WithBlock(),
-		grpc.WithTimeout(5*time.Second),
+		grpc.WithDefaultServiceConfig(serviceConfig),
 	)
+	conn, err := grpc.Dial(addr, opts...)
 
 	if err != nil {
 		return nil, fmt.Errorf("Error to connect to server: %s", err)
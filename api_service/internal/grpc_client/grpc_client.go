@@ -4,7 +4,6 @@ import (
 	"api_service/internal/grpc/server/user_grpc"
 	"context"
 	"fmt"
-	"time"
 
 	"google.golang.org/grpc"
 )
@@ -27,14 +26,10 @@ func (c *UserServiceClient) Login(ctx context.Context, req *user_grpc.LoginReque
 	return c.Client.Login(ctx, req)
 }
 
-func NewUserServiceClient(addr string) (*UserServiceClient, error) {
+func NewUserServiceClient(addr string, opts ...grpc.DialOption) (*UserServiceClient, error) {
 	//Make connection with user_service
-	conn, err := grpc.Dial(
-		addr,
-		grpc.WithInsecure(),
-		grpc.WithBlock(),
-		grpc.WithTimeout(5*time.Second),
-	)
+	opts = append(opts, grpc.WithInsecure())
+	conn, err := grpc.Dial(addr, opts...)
 
 	if err != nil {
 		return nil, fmt.Errorf("Error to connect to server: %s", err)
@@ -45,6 +40,11 @@ func NewUserServiceClient(addr string) (*UserServiceClient, error) {
 	}, nil
 }
 
+// Conn - соединение для проверок grpc.health.v1
+func (c *UserServiceClient) Conn() *grpc.ClientConn {
+	return c.connection
+}
+
 func (c *UserServiceClient) Close() error {
 	return c.connection.Close()
 }
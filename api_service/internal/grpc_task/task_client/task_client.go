@@ -3,7 +3,6 @@ package taskclient
 import (
 	task_server "api_service/internal/grpc_task"
 	"context"
-	"time"
 
 	"google.golang.org/grpc"
 )
@@ -106,13 +105,12 @@ func (t *TaskServiceClient) UpdateTask(ctx context.Context, req *task_server.Upd
 	return t.Client.UpdateTask(ctx, req)
 }
 
-func NewTaskServiceClient(addr string) (*TaskServiceClient, error) {
-	conn, err := grpc.Dial(
-		addr,
+func NewTaskServiceClient(addr string, opts ...grpc.DialOption) (*TaskServiceClient, error) {
+	opts = append(opts,
 		grpc.WithInsecure(),
-		grpc.WithBlock(),
-		grpc.WithTimeout(5*time.Second),
+		grpc.WithUnaryInterceptor(ValidationInterceptor()),
 	)
+	conn, err := grpc.Dial(addr, opts...)
 
 	if err != nil {
 		return nil, err
@@ -123,6 +121,11 @@ func NewTaskServiceClient(addr string) (*TaskServiceClient, error) {
 	}, nil
 }
 
+// Conn - соединение для проверок grpc.health.v1
+func (t *TaskServiceClient) Conn() *grpc.ClientConn {
+	return t.conn
+}
+
 func (t *TaskServiceClient) Close() error {
 	return t.conn.Close()
 }
@@ -0,0 +1,100 @@
+package taskclient
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validatorAll - запросы с методом ValidateAll, сгенерированным protoc-gen-validate
+type validatorAll interface {
+	ValidateAll() error
+}
+
+// fieldError - ошибка одного поля, которую возвращает protoc-gen-validate
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+type multiError interface {
+	AllErrors() []error
+}
+
+// Validate проверяет запрос правилами validate.rules из task.proto. Нарушения
+// возвращаются как InvalidArgument со списком полей в errdetails.BadRequest.
+func Validate(req any) error {
+	v, ok := req.(validatorAll)
+	if !ok {
+		return nil
+	}
+	err := v.ValidateAll()
+	if err == nil {
+		return nil
+	}
+
+	br := &errdetails.BadRequest{FieldViolations: violations("", err)}
+	st, detailsErr := status.New(codes.InvalidArgument, "invalid request").WithDetails(br)
+	if detailsErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st.Err()
+}
+
+func violations(prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	var multi multiError
+	if errors.As(err, &multi) {
+		var out []*errdetails.BadRequest_FieldViolation
+		for _, e := range multi.AllErrors() {
+			out = append(out, violations(prefix, e)...)
+		}
+		return out
+	}
+
+	var fe fieldError
+	if !errors.As(err, &fe) {
+		return []*errdetails.BadRequest_FieldViolation{{Field: prefix, Description: err.Error()}}
+	}
+	field := snakeCase(fe.Field())
+	if prefix != "" {
+		field = prefix + "." + field
+	}
+	// Ошибка во вложенном сообщении - раскрываем её причину
+	if fe.Cause() != nil {
+		return violations(field, fe.Cause())
+	}
+	return []*errdetails.BadRequest_FieldViolation{{Field: field, Description: fe.Reason()}}
+}
+
+// snakeCase переводит имя Go поля (FolderId) в имя поля proto/json (folder_id)
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// ValidationInterceptor не отправляет в task_service запросы, нарушающие validate.rules
+func ValidationInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := Validate(req); err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,62 @@
+package taskclient
+
+import (
+	task_server "api_service/internal/grpc_task"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate(&task_server.CreateTaskRequest{
+		UserId:   1,
+		FolderId: 2,
+		Title:    "buy milk",
+		Priority: 3,
+	}))
+
+	err := Validate(&task_server.CreateTaskRequest{
+		UserId:      1,
+		FolderId:    0,
+		Description: strings.Repeat("a", 501),
+		Priority:    9,
+	})
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	require.Len(t, st.Details(), 1)
+	br := st.Details()[0].(*errdetails.BadRequest)
+	var fields []string
+	for _, v := range br.GetFieldViolations() {
+		fields = append(fields, v.GetField())
+		assert.NotEmpty(t, v.GetDescription())
+	}
+	assert.Equal(t, []string{"folder_id", "title", "description", "priority"}, fields)
+}
+
+func TestValidationInterceptor(t *testing.T) {
+	called := false
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		called = true
+		return nil
+	}
+	interceptor := ValidationInterceptor()
+
+	err := interceptor(context.Background(), "/task_service.TaskService/GetTask",
+		&task_server.GetTaskRequest{UserId: 1}, nil, nil, invoker)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.False(t, called)
+
+	err = interceptor(context.Background(), "/task_service.TaskService/GetTask",
+		&task_server.GetTaskRequest{UserId: 1, TaskId: 5}, nil, nil, invoker)
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
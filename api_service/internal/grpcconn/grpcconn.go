@@ -0,0 +1,39 @@
+// Package grpcconn - общие опции соединений api_service с gRPC сервисами
+package grpcconn
+
+import (
+	"grpcmw"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+)
+
+// Backoff - задержки между попытками переподключения
+type Backoff struct {
+	Base time.Duration
+	Max  time.Duration
+}
+
+// Options - соединение не блокирует старт: gRPC подключается в фоне и
+// переподключается с экспоненциальной задержкой и jitter, пока сервис не поднимется.
+// Пока соединения нет, /readyz отвечает 503. Каждый вызов проходит через общие
+// interceptor'ы grpcmw (request ID, метрики и лог) и создаёт клиентский спан,
+// передавая traceparent в metadata.
+func Options(b Backoff, m *grpcmw.Metrics, logger *slog.Logger) []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(grpcmw.UnaryClientInterceptors(m, logger)...),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff: backoff.Config{
+				BaseDelay:  b.Base,
+				Multiplier: backoff.DefaultConfig.Multiplier,
+				Jitter:     backoff.DefaultConfig.Jitter,
+				MaxDelay:   b.Max,
+			},
+			MinConnectTimeout: 5 * time.Second,
+		}),
+	}
+}
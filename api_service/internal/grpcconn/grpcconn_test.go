@@ -0,0 +1,51 @@
+package grpcconn
+
+import (
+	"context"
+	"grpcmw"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Сервис, поднявшийся после клиента, становится доступен без перезапуска клиента
+func TestDialBeforeServerStarts(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := lis.Addr().String()
+	lis.Close()
+
+	opts := append(Options(Backoff{Base: 10 * time.Millisecond, Max: 50 * time.Millisecond}, grpcmw.NewMetrics(), slog.Default()),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial(addr, opts...)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	_, err = client.Check(ctx, &healthpb.HealthCheckRequest{})
+	cancel()
+	assert.Error(t, err)
+
+	lis, err = net.Listen("tcp", addr)
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	healthpb.RegisterHealthServer(srv, grpchealth.NewServer())
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	assert.Eventually(t, func() bool {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		_, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+		return err == nil
+	}, 2*time.Second, 20*time.Millisecond)
+}
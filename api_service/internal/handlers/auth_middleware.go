@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"api_service/internal/auth"
+	"api_service/internal/problem"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// AuthMiddleware проверяет jwt и кладёт данные пользователя в контекст
+func AuthMiddleware(authn *auth.Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authToken, err := auth.TokenFromRequest(r, authn.TokenCookie)
+			switch {
+			case errors.Is(err, auth.ErrNoToken):
+				auth.Challenge(w, "", "", "")
+				problem.Write(w, r, http.StatusUnauthorized, "Authorization required")
+				return
+			case err != nil:
+				auth.Challenge(w, auth.ErrCodeInvalidRequest, err.Error(), "")
+				problem.Write(w, r, http.StatusBadRequest, "Malformed authorization")
+				return
+			}
+
+			session, err := authn.Authenticate(r.Context(), authToken)
+			switch {
+			case errors.Is(err, auth.ErrUnavailable):
+				problem.Write(w, r, http.StatusServiceUnavailable, "Error in server cache")
+				return
+			case errors.Is(err, auth.ErrRevoked):
+				auth.Challenge(w, auth.ErrCodeInvalidToken, "The access token has been revoked", "")
+				problem.Write(w, r, http.StatusUnauthorized, "Invalid jwt key")
+				return
+			case err != nil:
+				auth.Challenge(w, auth.ErrCodeInvalidToken, "The access token is invalid or expired", "")
+				problem.Write(w, r, http.StatusUnauthorized, "Invalid jwt key")
+				return
+			}
+			ctx := auth.WithPrincipal(r.Context(), auth.NewPrincipal(session, authToken))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequireScope пропускает только токены со всеми перечисленными scope, иначе 403 insufficient_scope.
+// Ставится после AuthMiddleware.
+func RequireScope(scopes ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			p, ok := requirePrincipal(w, r)
+			if !ok {
+				return
+			}
+			for _, scope := range scopes {
+				if !p.HasScope(scope) {
+					auth.Challenge(w, auth.ErrCodeInsufficientScope,
+						"The request requires higher privileges", strings.Join(scopes, " "))
+					problem.Write(w, r, http.StatusForbidden, "Forbidden")
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// requirePrincipal достаёт пользователя из контекста; если AuthMiddleware не отработал,
+// отвечает 401 вместо паники
+func requirePrincipal(w http.ResponseWriter, r *http.Request) (*auth.Principal, bool) {
+	p, ok := auth.FromContext(r.Context())
+	if !ok {
+		auth.Challenge(w, "", "", "")
+		problem.Write(w, r, http.StatusUnauthorized, "Authorization required")
+		return nil, false
+	}
+	return p, true
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"api_service/internal/auth"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskHandlersWithoutPrincipal(t *testing.T) {
+	h := &TaskServiceHandler{}
+	handlers := map[string]http.HandlerFunc{
+		"CreateFolder":         h.CreateFolder,
+		"GetUserFolders":       h.GetUserFolders,
+		"GetFolder":            h.GetFolder,
+		"UpdateFolder":         h.UpdateFolder,
+		"DeleteFolder":         h.DeleteFolder,
+		"CreateTask":           h.CreateTask,
+		"GetAllTasks":          h.GetAllTasks,
+		"GetTask":              h.GetTask,
+		"UpdateTask":           h.UpdateTask,
+		"DeleteTask":           h.DeleteTask,
+		"ToggleTaskCompletion": h.ToggleTaskCompletion,
+		"MoveTaskToFolder":     h.MoveTaskToFolder,
+		"SearchTasks":          h.SearchTasks,
+		"GetFolderTasks":       h.GetFolderTasks,
+	}
+
+	for name, handler := range handlers {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			assert.NotPanics(t, func() { handler(w, r) })
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+			assert.Equal(t, `Bearer realm="api_service"`, w.Header().Get("WWW-Authenticate"))
+		})
+	}
+}
+
+func TestRequireScope(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	handler := RequireScope("admin")(next)
+
+	serve := func(p *auth.Principal) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		if p != nil {
+			r = r.WithContext(auth.WithPrincipal(r.Context(), p))
+		}
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	assert.Equal(t, http.StatusUnauthorized, serve(nil).Code)
+
+	w := serve(&auth.Principal{UserID: 1})
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Header().Get("WWW-Authenticate"), `error="insufficient_scope"`)
+
+	assert.Equal(t, http.StatusNoContent, serve(&auth.Principal{UserID: 1, Scopes: []string{"admin"}}).Code)
+}
@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"api_service/internal/auth"
+	"api_service/internal/grpc/grpc_server"
+	"api_service/internal/problem"
+	"crypto/subtle"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// loginLocked проверяет блокировку до обращения к user_service, чтобы перебор
+// паролей не доходил до bcrypt. Недоступный cache_service вход не блокирует.
+func (h *UserAuthHandler) loginLocked(w http.ResponseWriter, r *http.Request, username, ip string) bool {
+	resp, err := h.cache.CheckLogin(r.Context(), &grpc_server.LoginAttemptRequest{
+		Username: username,
+		Ip:       ip,
+	})
+	if err != nil {
+		slog.WarnContext(r.Context(), "Login lockout check failed, login allowed", slog.Any("error", err))
+		return false
+	}
+	if resp.Locked {
+		writeLoginLocked(w, r, resp.RetryAfterMs)
+		return true
+	}
+	return false
+}
+
+// loginFailed учитывает неудачный вход; если он привёл к блокировке, отвечает 429
+func (h *UserAuthHandler) loginFailed(w http.ResponseWriter, r *http.Request, username, ip string) bool {
+	resp, err := h.cache.LoginFailed(r.Context(), &grpc_server.LoginAttemptRequest{
+		Username: username,
+		Ip:       ip,
+	})
+	if err != nil {
+		slog.WarnContext(r.Context(), "Error recording failed login", slog.Any("error", err))
+		return false
+	}
+	if resp.Locked {
+		slog.WarnContext(r.Context(), "Login locked after failed attempts",
+			slog.String("username", username), slog.String("ip", ip),
+			slog.Int("user_failures", int(resp.UserFailures)), slog.Int("ip_failures", int(resp.IpFailures)))
+		writeLoginLocked(w, r, resp.RetryAfterMs)
+		return true
+	}
+	return false
+}
+
+// loginSucceeded сбрасывает счётчик неудач пользователя
+func (h *UserAuthHandler) loginSucceeded(r *http.Request, username string) {
+	_, err := h.cache.LoginSucceeded(r.Context(), &grpc_server.LoginAttemptRequest{
+		Username: username,
+	})
+	if err != nil {
+		slog.WarnContext(r.Context(), "Error resetting failed logins", slog.Any("error", err))
+	}
+}
+
+func writeLoginLocked(w http.ResponseWriter, r *http.Request, retryAfterMs int64) {
+	w.Header().Set("Retry-After", strconv.FormatInt((retryAfterMs+999)/1000, 10))
+	problem.Write(w, r, http.StatusTooManyRequests, "Too many failed login attempts, retry later")
+}
+
+// AdminRoutes - служебное API для сервера метрик. Порт метрик опубликован, поэтому
+// каждый запрос должен предъявить token в Authorization: Bearer.
+func (h *UserAuthHandler) AdminRoutes(token string) http.Handler {
+	r := chi.NewRouter()
+	r.Use(requireAdminToken(token))
+	r.Get("/admin/login-locks", h.GetLoginLock)
+	r.Delete("/admin/login-locks", h.ClearLoginLock)
+	return r
+}
+
+// requireAdminToken пропускает только запросы с токеном администратора
+func requireAdminToken(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got, err := auth.TokenFromRequest(r, "")
+			if err != nil || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+				auth.Challenge(w, "", "", "")
+				problem.Write(w, r, http.StatusUnauthorized, "Admin token required")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// loginLockSubject читает username и ip из query; нужен хотя бы один
+func loginLockSubject(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	username := r.URL.Query().Get("username")
+	ip := r.URL.Query().Get("ip")
+	if username == "" && ip == "" {
+		problem.Write(w, r, http.StatusBadRequest, "username or ip is required")
+		return "", "", false
+	}
+	return username, ip, true
+}
+
+// GetLoginLock показывает блокировку и число неудачных попыток логина и/или адреса
+func (h *UserAuthHandler) GetLoginLock(w http.ResponseWriter, r *http.Request) {
+	username, ip, ok := loginLockSubject(w, r)
+	if !ok {
+		return
+	}
+	resp, err := h.cache.CheckLogin(r.Context(), &grpc_server.LoginAttemptRequest{
+		Username: username,
+		Ip:       ip,
+	})
+	if err != nil {
+		problem.WriteGRPC(w, r, err, "Error in server cache")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"locked":        resp.Locked,
+		"retry_after":   (resp.RetryAfterMs + 999) / 1000,
+		"user_failures": resp.UserFailures,
+		"ip_failures":   resp.IpFailures,
+	})
+}
+
+// ClearLoginLock снимает блокировку логина и/или адреса
+func (h *UserAuthHandler) ClearLoginLock(w http.ResponseWriter, r *http.Request) {
+	username, ip, ok := loginLockSubject(w, r)
+	if !ok {
+		return
+	}
+	resp, err := h.cache.ClearLoginLock(r.Context(), &grpc_server.ClearLoginLockRequest{
+		Username: username,
+		Ip:       ip,
+	})
+	if err != nil {
+		problem.WriteGRPC(w, r, err, "Error in server cache")
+		return
+	}
+	slog.InfoContext(r.Context(), "Login lock cleared",
+		slog.String("username", username), slog.String("ip", ip), slog.Int("cleared", int(resp.Cleared)))
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"cleared": resp.Cleared,
+	})
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"api_service/internal/grpc/grpc_server"
+	grpccache "api_service/internal/grpc_cache"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// fakeLockoutCache отвечает только на вызовы блокировки входа
+type fakeLockoutCache struct {
+	grpc_server.CacheServiceClient
+	status  *grpc_server.LoginAttemptResponse
+	checked *grpc_server.LoginAttemptRequest
+	cleared *grpc_server.ClearLoginLockRequest
+}
+
+func (f *fakeLockoutCache) CheckLogin(_ context.Context, req *grpc_server.LoginAttemptRequest, _ ...grpc.CallOption) (
+	*grpc_server.LoginAttemptResponse, error,
+) {
+	f.checked = req
+	return f.status, nil
+}
+
+func (f *fakeLockoutCache) ClearLoginLock(_ context.Context, req *grpc_server.ClearLoginLockRequest, _ ...grpc.CallOption) (
+	*grpc_server.ClearLoginLockResponse, error,
+) {
+	f.cleared = req
+	return &grpc_server.ClearLoginLockResponse{Cleared: 1}, nil
+}
+
+func TestLoginLocked(t *testing.T) {
+	fake := &fakeLockoutCache{status: &grpc_server.LoginAttemptResponse{Locked: true, RetryAfterMs: 90500}}
+	h := &UserAuthHandler{cache: &grpccache.CacheClient{Client: fake}}
+
+	r := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(`{"username":"alice","password":"x"}`))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	// Заблокированный вход не доходит до user_service (h.Client не задан)
+	assert.NotPanics(t, func() { h.Login(w, r) })
+
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "91", w.Header().Get("Retry-After"))
+	assert.Equal(t, "alice", fake.checked.Username)
+	assert.Equal(t, "192.0.2.1", fake.checked.Ip)
+}
+
+func TestAdminLoginLocks(t *testing.T) {
+	fake := &fakeLockoutCache{status: &grpc_server.LoginAttemptResponse{Locked: true, RetryAfterMs: 1000, IpFailures: 3}}
+	admin := (&UserAuthHandler{cache: &grpccache.CacheClient{Client: fake}}).AdminRoutes("admin-secret")
+
+	serve := func(method, target string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(method, target, nil)
+		r.Header.Set("Authorization", "Bearer admin-secret")
+		admin.ServeHTTP(w, r)
+		return w
+	}
+
+	w := serve(http.MethodGet, "/admin/login-locks?ip=203.0.113.5")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"locked":true,"retry_after":1,"user_failures":0,"ip_failures":3}`, w.Body.String())
+
+	w = serve(http.MethodDelete, "/admin/login-locks?username=alice")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "alice", fake.cleared.Username)
+	assert.JSONEq(t, `{"success":true,"cleared":1}`, w.Body.String())
+
+	w = serve(http.MethodDelete, "/admin/login-locks")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestAdminRoutesRequireToken(t *testing.T) {
+	fake := &fakeLockoutCache{}
+	admin := (&UserAuthHandler{cache: &grpccache.CacheClient{Client: fake}}).AdminRoutes("admin-secret")
+
+	for _, header := range []string{"", "Bearer wrong", "Basic YWRtaW46YWRtaW4="} {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodDelete, "/admin/login-locks?username=alice", nil)
+		if header != "" {
+			r.Header.Set("Authorization", header)
+		}
+		admin.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusUnauthorized, w.Code, header)
+		assert.Equal(t, `Bearer realm="api_service"`, w.Header().Get("WWW-Authenticate"))
+	}
+	assert.Nil(t, fake.cleared)
+}
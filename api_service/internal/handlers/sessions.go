@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"api_service/internal/grpc/grpc_server"
+	"api_service/internal/problem"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// sessionView - устройство пользователя в ответе GET /sessions
+type sessionView struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	LastSeen  time.Time `json:"last_seen"`
+	UserAgent string    `json:"user_agent"`
+	IP        string    `json:"ip"`
+	Current   bool      `json:"current"`
+}
+
+// ListSessions показывает активные сессии (устройства) пользователя
+func (h *UserAuthHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.cache.ListSessions(r.Context(), &grpc_server.ListSessionsRequest{
+		UserId: principal.UserID,
+	})
+	if err != nil {
+		problem.WriteGRPC(w, r, err, "Error in server cache")
+		return
+	}
+
+	sessions := make([]sessionView, 0, len(resp.Sessions))
+	for _, s := range resp.Sessions {
+		sessions = append(sessions, sessionView{
+			ID:        s.SessionId,
+			CreatedAt: time.Unix(s.CreatedAt, 0).UTC(),
+			LastSeen:  time.Unix(s.LastSeen, 0).UTC(),
+			UserAgent: s.UserAgent,
+			IP:        s.Ip,
+			Current:   s.SessionId == principal.SessionID,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"sessions": sessions,
+	})
+}
+
+// RevokeSession завершает одну сессию пользователя (выход на другом устройстве)
+func (h *UserAuthHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	sessionID := chi.URLParam(r, "sessionID")
+
+	resp, err := h.cache.RevokeSession(r.Context(), &grpc_server.RevokeSessionRequest{
+		UserId:    principal.UserID,
+		SessionId: sessionID,
+	})
+	if err != nil {
+		problem.WriteGRPC(w, r, err, "Error to delete from cache")
+		return
+	}
+	// Без удалённой сессии успехом ответ не считается
+	if !resp.Success {
+		problem.Write(w, r, http.StatusNotFound, "Session not found")
+		return
+	}
+	// Токены других сессий здесь неизвестны. Если кэш сессий включён, они
+	// проходят проверку до истечения SESSION_CACHE_TTL, и так же на других репликах.
+	if sessionID == principal.SessionID {
+		h.authn.Forget(principal.Token)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+	})
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"api_service/internal/auth"
+	"api_service/internal/grpc/grpc_server"
+	grpccache "api_service/internal/grpc_cache"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeSessionCache отвечает только на вызовы списка сессий; сессии из gone
+// уже удалены, и отзыв их ничего не находит
+type fakeSessionCache struct {
+	grpc_server.CacheServiceClient
+	sessions []*grpc_server.SessionInfo
+	gone     map[string]bool
+	revoked  *grpc_server.RevokeSessionRequest
+}
+
+func (f *fakeSessionCache) ListSessions(_ context.Context, _ *grpc_server.ListSessionsRequest, _ ...grpc.CallOption) (
+	*grpc_server.ListSessionsResponse, error,
+) {
+	return &grpc_server.ListSessionsResponse{Sessions: f.sessions}, nil
+}
+
+func (f *fakeSessionCache) RevokeSession(_ context.Context, req *grpc_server.RevokeSessionRequest, _ ...grpc.CallOption) (
+	*grpc_server.RevokeSessionResponse, error,
+) {
+	if f.gone[req.SessionId] {
+		return &grpc_server.RevokeSessionResponse{Success: false}, nil
+	}
+	for _, s := range f.sessions {
+		if s.SessionId == req.SessionId {
+			f.revoked = req
+			return &grpc_server.RevokeSessionResponse{Success: true}, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "session not found")
+}
+
+func newSessionsRouter(h *UserAuthHandler) http.Handler {
+	r := chi.NewRouter()
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			p := &auth.Principal{UserID: 7, SessionID: "current"}
+			next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), p)))
+		})
+	})
+	r.Get("/sessions", h.ListSessions)
+	r.Delete("/sessions/{sessionID}", h.RevokeSession)
+	return r
+}
+
+func TestSessions(t *testing.T) {
+	fake := &fakeSessionCache{sessions: []*grpc_server.SessionInfo{
+		{SessionId: "current", CreatedAt: 1700000000, LastSeen: 1700000600, UserAgent: "curl/8", Ip: "203.0.113.5"},
+		{SessionId: "phone", CreatedAt: 1690000000, LastSeen: 1690000000},
+	}, gone: map[string]bool{"tablet": true}}
+	router := newSessionsRouter(&UserAuthHandler{cache: &grpccache.CacheClient{Client: fake}})
+
+	serve := func(method, target string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(method, target, nil))
+		return w
+	}
+
+	w := serve(http.MethodGet, "/sessions")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"sessions":[
+		{"id":"current","created_at":"2023-11-14T22:13:20Z","last_seen":"2023-11-14T22:23:20Z","user_agent":"curl/8","ip":"203.0.113.5","current":true},
+		{"id":"phone","created_at":"2023-07-22T04:26:40Z","last_seen":"2023-07-22T04:26:40Z","user_agent":"","ip":"","current":false}
+	]}`, w.Body.String())
+
+	w = serve(http.MethodDelete, "/sessions/phone")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, int32(7), fake.revoked.UserId)
+	assert.Equal(t, "phone", fake.revoked.SessionId)
+
+	w = serve(http.MethodDelete, "/sessions/unknown")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = serve(http.MethodDelete, "/sessions/tablet")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	task_server "api_service/internal/grpc_task"
+	"api_service/internal/problem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// sortFields - допустимые значения параметра sort
+var sortFields = map[string]task_server.SortField_Field{
+	"due_time":   task_server.SortField_DUE_TIME,
+	"priority":   task_server.SortField_PRIORITY,
+	"created_at": task_server.SortField_CREATED_AT,
+	"title":      task_server.SortField_TITLE,
+}
+
+// queryParser разбирает query параметры и копит ошибки по всем полям,
+// чтобы вернуть их одним ответом 400
+type queryParser struct {
+	q      url.Values
+	errors []problem.FieldViolation
+}
+
+func (p *queryParser) fail(field, format string, args ...interface{}) {
+	p.errors = append(p.errors, problem.FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+func (p *queryParser) time(field string) *timestamppb.Timestamp {
+	v := p.q.Get(field)
+	if v == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		p.fail(field, "must be an RFC 3339 timestamp")
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func (p *queryParser) bool(field string) *bool {
+	v := p.q.Get(field)
+	if v == "" {
+		return nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		p.fail(field, "must be true or false")
+		return nil
+	}
+	return &b
+}
+
+func (p *queryParser) int32(field string) *int32 {
+	v := p.q.Get(field)
+	if v == "" {
+		return nil
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		p.fail(field, "must be an integer")
+		return nil
+	}
+	i := int32(n)
+	return &i
+}
+
+func (p *queryParser) priorities(field string) []int32 {
+	v := p.q.Get(field)
+	if v == "" {
+		return nil
+	}
+	var out []int32
+	seen := make(map[int64]bool)
+	for _, s := range strings.Split(v, ",") {
+		n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 32)
+		if err != nil || n < 1 || n > 5 {
+			p.fail(field, "must be a comma separated list of priorities 1-5")
+			return nil
+		}
+		if !seen[n] {
+			seen[n] = true
+			out = append(out, int32(n))
+		}
+	}
+	return out
+}
+
+// sort разбирает "due_time,-priority": минус перед полем - сортировка по убыванию
+func (p *queryParser) sort(field string) []*task_server.SortField {
+	v := p.q.Get(field)
+	if v == "" {
+		return nil
+	}
+	var out []*task_server.SortField
+	seen := make(map[task_server.SortField_Field]bool)
+	for _, s := range strings.Split(v, ",") {
+		name := strings.TrimSpace(s)
+		desc := strings.HasPrefix(name, "-")
+		name = strings.TrimPrefix(name, "-")
+		f, ok := sortFields[name]
+		if !ok {
+			p.fail(field, "unknown sort field %q, expected due_time, priority, created_at or title", name)
+			return nil
+		}
+		if seen[f] {
+			p.fail(field, "sort field %q is repeated", name)
+			return nil
+		}
+		seen[f] = true
+		out = append(out, &task_server.SortField{Field: f, Descending: desc})
+	}
+	return out
+}
+
+// writeQueryErrors отвечает 400 со списком неверных параметров
+func writeQueryErrors(w http.ResponseWriter, r *http.Request, errs []problem.FieldViolation) {
+	p := problem.New(http.StatusBadRequest, "Invalid query parameters")
+	p.Errors = errs
+	p.Write(w, r)
+}
+
+// parseTaskListQuery заполняет фильтры и сортировку GET /tasks
+func parseTaskListQuery(q url.Values, req *task_server.GetAllTasksRequest) []problem.FieldViolation {
+	p := &queryParser{q: q}
+	req.FolderId = p.int32("folder_id")
+	req.Sort = p.sort("sort")
+	req.DueAfter = p.time("due_after")
+	req.DueBefore = p.time("due_before")
+	req.CreatedAfter = p.time("created_after")
+	req.PriorityIn = p.priorities("priority_in")
+	req.Completed = p.bool("completed")
+	if overdue := p.bool("overdue"); overdue != nil {
+		req.Overdue = *overdue
+	}
+	return p.errors
+}
+
+// parseSearchQuery заполняет фильтры GET /tasks/search
+func parseSearchQuery(q url.Values, req *task_server.SearchTasksRequest) []problem.FieldViolation {
+	p := &queryParser{q: q}
+	req.Completed = p.bool("completed")
+	req.Priority = p.int32("priority")
+	req.DueBefore = p.time("due_before")
+	return p.errors
+}
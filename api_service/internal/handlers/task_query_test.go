@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	task_server "api_service/internal/grpc_task"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTaskListQuery(t *testing.T) {
+	q, err := url.ParseQuery("sort=due_time,-priority&due_after=2025-01-01T00:00:00Z" +
+		"&priority_in=1,2,2&completed=false&overdue=true&folder_id=4")
+	require.NoError(t, err)
+
+	req := &task_server.GetAllTasksRequest{}
+	assert.Empty(t, parseTaskListQuery(q, req))
+
+	require.Len(t, req.Sort, 2)
+	assert.Equal(t, task_server.SortField_DUE_TIME, req.Sort[0].Field)
+	assert.False(t, req.Sort[0].Descending)
+	assert.Equal(t, task_server.SortField_PRIORITY, req.Sort[1].Field)
+	assert.True(t, req.Sort[1].Descending)
+	assert.Equal(t, int64(1735689600), req.DueAfter.GetSeconds())
+	assert.Nil(t, req.DueBefore)
+	assert.Equal(t, []int32{1, 2}, req.PriorityIn)
+	require.NotNil(t, req.Completed)
+	assert.False(t, *req.Completed)
+	assert.True(t, req.Overdue)
+	assert.Equal(t, int32(4), req.GetFolderId())
+}
+
+func TestParseTaskListQueryErrors(t *testing.T) {
+	q, err := url.ParseQuery("sort=owner&due_before=tomorrow&priority_in=0&completed=maybe&overdue=1x&folder_id=a")
+	require.NoError(t, err)
+
+	errs := parseTaskListQuery(q, &task_server.GetAllTasksRequest{})
+	var fields []string
+	for _, e := range errs {
+		fields = append(fields, e.Field)
+	}
+	assert.Equal(t, []string{"folder_id", "sort", "due_before", "priority_in", "completed", "overdue"}, fields)
+
+	q, err = url.ParseQuery("sort=title,-title")
+	require.NoError(t, err)
+	assert.Len(t, parseTaskListQuery(q, &task_server.GetAllTasksRequest{}), 1)
+}
+
+func TestParseSearchQuery(t *testing.T) {
+	q, err := url.ParseQuery("query=milk&completed=yes&priority=high")
+	require.NoError(t, err)
+	assert.Len(t, parseSearchQuery(q, &task_server.SearchTasksRequest{}), 2)
+}
@@ -1,67 +1,77 @@
 package handlers
 
 import (
-	"api_service/internal/grpc/grpc_server"
-	grpccache "api_service/internal/grpc_cache"
+	"api_service/internal/auth"
 	task_server "api_service/internal/grpc_task"
 	taskclient "api_service/internal/grpc_task/task_client"
 	"api_service/internal/models"
-	"context"
+	"api_service/internal/pagination"
+	"api_service/internal/problem"
+	"api_service/internal/ratelimit"
 	"encoding/json"
 	"net/http"
 	"strconv"
-	"time"
 
 	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type TaskServiceHandler struct {
-	Client *taskclient.TaskServiceClient
-	Cache  *grpccache.CacheClient
+	Client  *taskclient.TaskServiceClient
+	Auth    *auth.Authenticator
+	Cursors *pagination.Signer
+	// Limits - ограничение частоты запросов, nil - без ограничений
+	Limits *ratelimit.Limiter
 }
 
 func (h *TaskServiceHandler) Close() error {
 	return h.Client.Close()
 }
 
-func NewTaskServiceClient(addr_task string, cache *grpccache.CacheClient) (*TaskServiceHandler, error) {
-	task_service, err := taskclient.NewTaskServiceClient("task_service:50052")
+func NewTaskServiceClient(addr_task string, authn *auth.Authenticator, cursors *pagination.Signer, opts ...grpc.DialOption) (*TaskServiceHandler, error) {
+	task_service, err := taskclient.NewTaskServiceClient(addr_task, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &TaskServiceHandler{
-		Client: task_service,
-		Cache:  cache,
+		Client:  task_service,
+		Auth:    authn,
+		Cursors: cursors,
 	}, nil
 }
 
 func (h *TaskServiceHandler) AuthMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		authToken := r.Header.Get("Authorization")
-		if authToken == "" {
-			http.Error(w, "Error authorization", http.StatusBadRequest)
-			return
-		}
-		response, err := h.Cache.GetUser(r.Context(), &grpc_server.GetUserRequest{
-			JwtKey: authToken,
-		})
-		if err != nil {
-			http.Error(w, "Error in server cache", http.StatusConflict)
-			return
-		}
-		if !response.Success {
-			http.Error(w, "Invalid jwt key", http.StatusConflict)
-			return
-		}
-		ctx := context.WithValue(r.Context(), "user_id", response.UserId)
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	return AuthMiddleware(h.Auth)(next)
+}
+
+// page читает limit и cursor; курсор привязан к списку и пользователю
+func (h *TaskServiceHandler) page(w http.ResponseWriter, r *http.Request, scope string) (pagination.Page, bool) {
+	page, err := h.Cursors.Parse(r, scope)
+	if err != nil {
+		problem.Write(w, r, http.StatusBadRequest, err.Error())
+		return page, false
+	}
+	return page, true
+}
+
+// nextCursor возвращает курсор следующей страницы (nil на последней) и ставит Link
+func (h *TaskServiceHandler) nextCursor(w http.ResponseWriter, r *http.Request, next *task_server.Cursor, scope string, page pagination.Page) (interface{}, bool) {
+	cursor, err := h.Cursors.Next(w, r, next, scope, page.Limit)
+	if err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "Error encoding cursor")
+		return nil, false
+	}
+	if cursor == "" {
+		return nil, true
+	}
+	return cursor, true
 }
 
 // RegisterRoutes регистрирует все маршруты для задач и папок
 func (h *TaskServiceHandler) RegisterRoutes(r chi.Router) {
 	r.Use(h.AuthMiddleware)
+	r.Use(h.Limits.Handler("default"))
 
 	r.Route("/folders", func(r chi.Router) {
 		r.Get("/", h.GetUserFolders)
@@ -84,24 +94,33 @@ func (h *TaskServiceHandler) RegisterRoutes(r chi.Router) {
 			r.Put("/toggle", h.ToggleTaskCompletion)
 			r.Put("/move", h.MoveTaskToFolder)
 		})
-		r.Get("/search", h.SearchTasks)
+		// Поиск дороже обычных запросов, у него отдельная квота поверх default
+		r.With(h.Limits.Handler("search")).Get("/search", h.SearchTasks)
 	})
 }
 
 // Folder handlers
 func (h *TaskServiceHandler) CreateFolder(w http.ResponseWriter, r *http.Request) {
-	user_id := r.Context().Value("user_id").(int32)
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	user_id := principal.UserID
 	var folder models.FolderModel
 	if err := json.NewDecoder(r.Body).Decode(&folder); err != nil {
-		http.Error(w, "invalid json object", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "invalid json object")
 		return
 	}
-	resp, err := h.Client.CreateFolder(r.Context(), &task_server.CreateFolderRequest{
+	req := &task_server.CreateFolderRequest{
 		UserId: user_id,
 		Name:   folder.Name,
-	})
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+	resp, err := h.Client.CreateFolder(r.Context(), req)
 	if err != nil {
-		http.Error(w, "Error to create folder", http.StatusBadGateway)
+		problem.WriteGRPC(w, r, err, "Error to create folder")
 		return
 	}
 	w.Header().Set("Content-Type", "application/json")
@@ -114,34 +133,63 @@ func (h *TaskServiceHandler) CreateFolder(w http.ResponseWriter, r *http.Request
 }
 
 func (h *TaskServiceHandler) GetUserFolders(w http.ResponseWriter, r *http.Request) {
-	userID := r.Context().Value("user_id").(int32)
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	userID := principal.UserID
+	scope := pagination.Scope(userID, r)
+	page, ok := h.page(w, r, scope)
+	if !ok {
+		return
+	}
 
-	resp, err := h.Client.GetUserFolders(r.Context(), &task_server.GetFoldersRequest{
-		UserId: userID,
-	})
+	req := &task_server.GetFoldersRequest{
+		UserId:     userID,
+		Pagination: page.Pagination(),
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+	resp, err := h.Client.GetUserFolders(r.Context(), req)
 	if err != nil {
-		http.Error(w, "Error getting folders", http.StatusBadGateway)
+		problem.WriteGRPC(w, r, err, "Error getting folders")
+		return
+	}
+	next, ok := h.nextCursor(w, r, resp.NextCursor, scope, page)
+	if !ok {
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(resp.Folders)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"folders":     resp.Folders,
+		"next_cursor": next,
+	})
 }
 
 func (h *TaskServiceHandler) GetFolder(w http.ResponseWriter, r *http.Request) {
-	userID := r.Context().Value("user_id").(int32)
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	userID := principal.UserID
 	folderID, err := strconv.ParseInt(chi.URLParam(r, "folderID"), 10, 32)
 	if err != nil {
-		http.Error(w, "Invalid folder ID", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid folder ID")
 		return
 	}
 
-	resp, err := h.Client.GetFolder(r.Context(), &task_server.GetFolderRequest{
+	req := &task_server.GetFolderRequest{
 		UserId:   userID,
 		FolderId: int32(folderID),
-	})
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+	resp, err := h.Client.GetFolder(r.Context(), req)
 	if err != nil {
-		http.Error(w, "Error getting folder", http.StatusBadGateway)
+		problem.WriteGRPC(w, r, err, "Error getting folder")
 		return
 	}
 
@@ -150,26 +198,34 @@ func (h *TaskServiceHandler) GetFolder(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *TaskServiceHandler) UpdateFolder(w http.ResponseWriter, r *http.Request) {
-	userID := r.Context().Value("user_id").(int32)
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	userID := principal.UserID
 	folderID, err := strconv.ParseInt(chi.URLParam(r, "folderID"), 10, 32)
 	if err != nil {
-		http.Error(w, "Invalid folder ID", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid folder ID")
 		return
 	}
 
 	var folder models.FolderModel
 	if err := json.NewDecoder(r.Body).Decode(&folder); err != nil {
-		http.Error(w, "invalid json object", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "invalid json object")
 		return
 	}
 
-	resp, err := h.Client.UpdateFolder(r.Context(), &task_server.UpdateFolderRequest{
+	req := &task_server.UpdateFolderRequest{
 		FolderId: int32(folderID),
 		UserId:   userID,
 		NewName:  folder.Name,
-	})
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+	resp, err := h.Client.UpdateFolder(r.Context(), req)
 	if err != nil {
-		http.Error(w, "Error updating folder", http.StatusBadGateway)
+		problem.WriteGRPC(w, r, err, "Error updating folder")
 		return
 	}
 
@@ -178,19 +234,27 @@ func (h *TaskServiceHandler) UpdateFolder(w http.ResponseWriter, r *http.Request
 }
 
 func (h *TaskServiceHandler) DeleteFolder(w http.ResponseWriter, r *http.Request) {
-	userID := r.Context().Value("user_id").(int32)
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	userID := principal.UserID
 	folderID, err := strconv.ParseInt(chi.URLParam(r, "folderID"), 10, 32)
 	if err != nil {
-		http.Error(w, "Invalid folder ID", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid folder ID")
 		return
 	}
 
-	resp, err := h.Client.DeleteFolder(r.Context(), &task_server.DeleteFolderRequest{
+	req := &task_server.DeleteFolderRequest{
 		FolderId: int32(folderID),
 		UserId:   userID,
-	})
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+	resp, err := h.Client.DeleteFolder(r.Context(), req)
 	if err != nil {
-		http.Error(w, "Error deleting folder", http.StatusBadGateway)
+		problem.WriteGRPC(w, r, err, "Error deleting folder")
 		return
 	}
 
@@ -202,79 +266,120 @@ func (h *TaskServiceHandler) DeleteFolder(w http.ResponseWriter, r *http.Request
 }
 
 func (h *TaskServiceHandler) GetFolderTasks(w http.ResponseWriter, r *http.Request) {
-	userID := r.Context().Value("user_id").(int32)
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	userID := principal.UserID
 	folderID, err := strconv.ParseInt(chi.URLParam(r, "folderID"), 10, 32)
 	if err != nil {
-		http.Error(w, "Invalid folder ID", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid folder ID")
 		return
 	}
 
 	folderid := int32(folderID)
-	resp, err := h.Client.GetAllTasks(r.Context(), &task_server.GetAllTasksRequest{
-		UserId:   userID,
-		FolderId: &folderid,
-	})
+	scope := pagination.Scope(userID, r)
+	page, ok := h.page(w, r, scope)
+	if !ok {
+		return
+	}
+	req := &task_server.GetAllTasksRequest{
+		UserId:     userID,
+		FolderId:   &folderid,
+		Pagination: page.Pagination(),
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+	resp, err := h.Client.GetAllTasks(r.Context(), req)
 	if err != nil {
-		http.Error(w, "Error getting folder tasks", http.StatusBadGateway)
+		problem.WriteGRPC(w, r, err, "Error getting folder tasks")
+		return
+	}
+	next, ok := h.nextCursor(w, r, resp.NextCursor, scope, page)
+	if !ok {
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"tasks": resp.Tasks,
+		"tasks":       resp.Tasks,
+		"total_count": resp.TotalCount,
+		"next_cursor": next,
 	})
 }
 
 // Task handlers
 
 func (h *TaskServiceHandler) GetAllTasks(w http.ResponseWriter, r *http.Request) {
-	userID := r.Context().Value("user_id").(int32)
-	folderID := r.URL.Query().Get("folder_id")
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	userID := principal.UserID
+	scope := pagination.Scope(userID, r)
+	page, ok := h.page(w, r, scope)
+	if !ok {
+		return
+	}
 
 	req := &task_server.GetAllTasksRequest{
-		UserId: userID,
+		UserId:     userID,
+		Pagination: page.Pagination(),
 	}
-	if folderID != "" {
-		fID, err := strconv.ParseInt(folderID, 10, 32)
-		if err != nil {
-			http.Error(w, "Invalid folder_id", http.StatusBadRequest)
-			return
-		}
-		folderid := int32(fID)
-		req.FolderId = &folderid
+	if errs := parseTaskListQuery(r.URL.Query(), req); errs != nil {
+		writeQueryErrors(w, r, errs)
+		return
 	}
 
+	if !validateRequest(w, r, req) {
+		return
+	}
 	resp, err := h.Client.GetAllTasks(r.Context(), req)
 	if err != nil {
-		http.Error(w, "Error getting tasks", http.StatusBadGateway)
+		problem.WriteGRPC(w, r, err, "Error getting tasks")
+		return
+	}
+	next, ok := h.nextCursor(w, r, resp.NextCursor, scope, page)
+	if !ok {
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"tasks": resp.Tasks,
+		"tasks":       resp.Tasks,
+		"total_count": resp.TotalCount,
+		"next_cursor": next,
 	})
 }
 
 func (h *TaskServiceHandler) CreateTask(w http.ResponseWriter, r *http.Request) {
-	userID := r.Context().Value("user_id").(int32)
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	userID := principal.UserID
 
 	var task models.TaskModel
 	if err := json.NewDecoder(r.Body).Decode(&task); err != nil {
-		http.Error(w, "invalid json object", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "invalid json object")
 		return
 	}
 
-	resp, err := h.Client.CreateTask(r.Context(), &task_server.CreateTaskRequest{
+	req := &task_server.CreateTaskRequest{
 		UserId:      userID,
 		FolderId:    task.FolderID,
 		Title:       task.Title,
 		Description: task.Description,
 		DueTime:     timestamppb.New(task.Due_time),
 		Priority:    task.Priority,
-	})
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+	resp, err := h.Client.CreateTask(r.Context(), req)
 	if err != nil {
-		http.Error(w, "Error creating task", http.StatusBadGateway)
+		problem.WriteGRPC(w, r, err, "Error creating task")
 		return
 	}
 
@@ -283,19 +388,27 @@ func (h *TaskServiceHandler) CreateTask(w http.ResponseWriter, r *http.Request)
 }
 
 func (h *TaskServiceHandler) GetTask(w http.ResponseWriter, r *http.Request) {
-	userID := r.Context().Value("user_id").(int32)
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	userID := principal.UserID
 	taskID, err := strconv.ParseInt(chi.URLParam(r, "taskID"), 10, 32)
 	if err != nil {
-		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid task ID")
 		return
 	}
 
-	resp, err := h.Client.GetTask(r.Context(), &task_server.GetTaskRequest{
+	req := &task_server.GetTaskRequest{
 		UserId: userID,
 		TaskId: int32(taskID),
-	})
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+	resp, err := h.Client.GetTask(r.Context(), req)
 	if err != nil {
-		http.Error(w, "Error getting task", http.StatusBadGateway)
+		problem.WriteGRPC(w, r, err, "Error getting task")
 		return
 	}
 
@@ -304,16 +417,20 @@ func (h *TaskServiceHandler) GetTask(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *TaskServiceHandler) UpdateTask(w http.ResponseWriter, r *http.Request) {
-	userID := r.Context().Value("user_id").(int32)
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	userID := principal.UserID
 	taskID, err := strconv.ParseInt(chi.URLParam(r, "taskID"), 10, 32)
 	if err != nil {
-		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid task ID")
 		return
 	}
 
 	var task models.TaskModel
 	if err := json.NewDecoder(r.Body).Decode(&task); err != nil {
-		http.Error(w, "invalid json object", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "invalid json object")
 		return
 	}
 
@@ -333,9 +450,12 @@ func (h *TaskServiceHandler) UpdateTask(w http.ResponseWriter, r *http.Request)
 		req.DueTime = timestamppb.New(task.Due_time)
 	}
 
+	if !validateRequest(w, r, req) {
+		return
+	}
 	resp, err := h.Client.UpdateTask(r.Context(), req)
 	if err != nil {
-		http.Error(w, "Error updating task", http.StatusBadGateway)
+		problem.WriteGRPC(w, r, err, "Error updating task")
 		return
 	}
 
@@ -344,19 +464,27 @@ func (h *TaskServiceHandler) UpdateTask(w http.ResponseWriter, r *http.Request)
 }
 
 func (h *TaskServiceHandler) DeleteTask(w http.ResponseWriter, r *http.Request) {
-	userID := r.Context().Value("user_id").(int32)
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	userID := principal.UserID
 	taskID, err := strconv.ParseInt(chi.URLParam(r, "taskID"), 10, 32)
 	if err != nil {
-		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid task ID")
 		return
 	}
 
-	resp, err := h.Client.DeleteTask(r.Context(), &task_server.DeleteTaskRequest{
+	req := &task_server.DeleteTaskRequest{
 		TaskId: int32(taskID),
 		UserId: userID,
-	})
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+	resp, err := h.Client.DeleteTask(r.Context(), req)
 	if err != nil {
-		http.Error(w, "Error deleting task", http.StatusBadGateway)
+		problem.WriteGRPC(w, r, err, "Error deleting task")
 		return
 	}
 
@@ -368,19 +496,27 @@ func (h *TaskServiceHandler) DeleteTask(w http.ResponseWriter, r *http.Request)
 }
 
 func (h *TaskServiceHandler) ToggleTaskCompletion(w http.ResponseWriter, r *http.Request) {
-	userID := r.Context().Value("user_id").(int32)
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	userID := principal.UserID
 	taskID, err := strconv.ParseInt(chi.URLParam(r, "taskID"), 10, 32)
 	if err != nil {
-		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid task ID")
 		return
 	}
 
-	resp, err := h.Client.ToggleTaskCompletion(r.Context(), &task_server.ToggleTaskRequest{
+	req := &task_server.ToggleTaskRequest{
 		TaskId: int32(taskID),
 		UserId: userID,
-	})
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+	resp, err := h.Client.ToggleTaskCompletion(r.Context(), req)
 	if err != nil {
-		http.Error(w, "Error toggling task completion", http.StatusBadGateway)
+		problem.WriteGRPC(w, r, err, "Error toggling task completion")
 		return
 	}
 
@@ -389,10 +525,14 @@ func (h *TaskServiceHandler) ToggleTaskCompletion(w http.ResponseWriter, r *http
 }
 
 func (h *TaskServiceHandler) MoveTaskToFolder(w http.ResponseWriter, r *http.Request) {
-	userID := r.Context().Value("user_id").(int32)
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	userID := principal.UserID
 	taskID, err := strconv.ParseInt(chi.URLParam(r, "taskID"), 10, 32)
 	if err != nil {
-		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid task ID")
 		return
 	}
 
@@ -400,17 +540,21 @@ func (h *TaskServiceHandler) MoveTaskToFolder(w http.ResponseWriter, r *http.Req
 		NewFolderID int32 `json:"new_folder_id"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&moveReq); err != nil {
-		http.Error(w, "invalid json object", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "invalid json object")
 		return
 	}
 
-	resp, err := h.Client.MoveTaskToFolder(r.Context(), &task_server.MoveTaskRequest{
+	req := &task_server.MoveTaskRequest{
 		TaskId:      int32(taskID),
 		UserId:      userID,
 		NewFolderId: moveReq.NewFolderID,
-	})
+	}
+	if !validateRequest(w, r, req) {
+		return
+	}
+	resp, err := h.Client.MoveTaskToFolder(r.Context(), req)
 	if err != nil {
-		http.Error(w, "Error moving task", http.StatusBadGateway)
+		problem.WriteGRPC(w, r, err, "Error moving task")
 		return
 	}
 
@@ -419,42 +563,38 @@ func (h *TaskServiceHandler) MoveTaskToFolder(w http.ResponseWriter, r *http.Req
 }
 
 func (h *TaskServiceHandler) SearchTasks(w http.ResponseWriter, r *http.Request) {
-	userID := r.Context().Value("user_id").(int32)
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	userID := principal.UserID
 	query := r.URL.Query().Get("query")
-	completed := r.URL.Query().Get("completed")
-	priority := r.URL.Query().Get("priority")
-	dueBefore := r.URL.Query().Get("due_before")
-
-	req := &task_server.SearchTasksRequest{
-		UserId: userID,
-		Query:  query,
+	scope := pagination.Scope(userID, r)
+	page, ok := h.page(w, r, scope)
+	if !ok {
+		return
 	}
 
-	if completed != "" {
-		comp, err := strconv.ParseBool(completed)
-		if err == nil {
-			req.Completed = &comp
-		}
+	req := &task_server.SearchTasksRequest{
+		UserId:     userID,
+		Query:      query,
+		Pagination: page.Pagination(),
 	}
-
-	if priority != "" {
-		prio, err := strconv.ParseInt(priority, 10, 32)
-		if err == nil {
-			prt := int32(prio)
-			req.Priority = &prt
-		}
+	if errs := parseSearchQuery(r.URL.Query(), req); errs != nil {
+		writeQueryErrors(w, r, errs)
+		return
 	}
 
-	if dueBefore != "" {
-		dueTime, err := time.Parse(time.RFC3339, dueBefore)
-		if err == nil {
-			req.DueBefore = timestamppb.New(dueTime)
-		}
+	if !validateRequest(w, r, req) {
+		return
 	}
-
 	resp, err := h.Client.SearchTasks(r.Context(), req)
 	if err != nil {
-		http.Error(w, "Error searching tasks", http.StatusBadGateway)
+		problem.WriteGRPC(w, r, err, "Error searching tasks")
+		return
+	}
+	next, ok := h.nextCursor(w, r, resp.NextCursor, scope, page)
+	if !ok {
 		return
 	}
 
@@ -462,5 +602,6 @@ func (h *TaskServiceHandler) SearchTasks(w http.ResponseWriter, r *http.Request)
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"tasks":       resp.Tasks,
 		"total_count": resp.TotalCount,
+		"next_cursor": next,
 	})
 }
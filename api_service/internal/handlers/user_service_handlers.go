@@ -1,51 +1,79 @@
 package handlers
 
 import (
+	"api_service/internal/auth"
+	"api_service/internal/clientip"
 	"api_service/internal/grpc/grpc_server"
 	"api_service/internal/grpc/server/user_grpc"
 	grpccache "api_service/internal/grpc_cache"
 	grpcclient "api_service/internal/grpc_client"
 	"api_service/internal/models"
+	"api_service/internal/problem"
+	"api_service/internal/ratelimit"
+	"context"
 	"encoding/json"
+	"errors"
+	"log/slog"
 	"math/rand"
 	"net/http"
 	"runtime"
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// revokeTimeout ограничивает отзыв сессии после неудачного входа
+const revokeTimeout = 5 * time.Second
+
 type UserAuthHandler struct {
 	Client *grpcclient.UserServiceClient
 	cache  *grpccache.CacheClient
+	tokens *auth.TokenIssuer
+	authn  *auth.Authenticator
+	// Limits - ограничение частоты запросов, nil - без ограничений
+	Limits *ratelimit.Limiter
 }
 
 func (h *UserAuthHandler) RegisterRoutes(r chi.Router) {
-	r.Post("/register", h.Register)
-	r.Post("/login", h.Login)
+	// Анонимные маршруты ограничиваются по IP клиента
+	r.With(h.Limits.Handler("register")).Post("/register", h.Register)
+	r.With(h.Limits.Handler("login")).Post("/login", h.Login)
+	r.With(h.Limits.Handler("refresh")).Post("/token/refresh", h.RefreshToken)
 	r.Get("/crash", h.Crash)
+
+	r.Group(func(r chi.Router) {
+		r.Use(AuthMiddleware(h.authn))
+		r.Use(h.Limits.Handler("default"))
+		r.Post("/logout", h.Logout)
+		r.Post("/logout/all", h.LogoutAll)
+		r.Get("/sessions", h.ListSessions)
+		r.Delete("/sessions/{sessionID}", h.RevokeSession)
+	})
 }
 
 func (h *UserAuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		problem.Write(w, r, http.StatusMethodNotAllowed, "Method not allowed")
 		return
 	}
 
 	if r.Header.Get("Content-Type") != "application/json" {
-		http.Error(w, "Content-Type must be application/json", http.StatusUnsupportedMediaType)
+		problem.Write(w, r, http.StatusUnsupportedMediaType, "Content-Type must be application/json")
 		return
 	}
 
 	var user models.UserModel
 	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	// Basic validation
 	if user.Username == "" || user.Email == "" || user.Password == "" {
-		http.Error(w, "Username, email and password are required", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Username, email and password are required")
 		return
 	}
 
@@ -57,12 +85,13 @@ func (h *UserAuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 	})
 
 	if err != nil {
-		h.handleGRPCError(w, err)
+		h.handleGRPCError(w, r, err)
 		return
 	}
 
+	// Старые версии user_service сообщают о занятом логине только полем error
 	if resp.Error != "" {
-		http.Error(w, resp.Error, http.StatusConflict)
+		problem.Write(w, r, http.StatusConflict, resp.Error)
 		return
 	}
 
@@ -78,23 +107,28 @@ func (h *UserAuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 
 func (h *UserAuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		problem.Write(w, r, http.StatusMethodNotAllowed, "Method not allowed")
 		return
 	}
 
 	if r.Header.Get("Content-Type") != "application/json" {
-		http.Error(w, "Content-Type must be application/json", http.StatusUnsupportedMediaType)
+		problem.Write(w, r, http.StatusUnsupportedMediaType, "Content-Type must be application/json")
 		return
 	}
 
 	var user models.UserModel
 	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	if user.Username == "" || user.Password == "" {
-		http.Error(w, "Username and password are required", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Username and password are required")
+		return
+	}
+
+	ip := clientip.FromRequest(r)
+	if h.loginLocked(w, r, user.Username, ip) {
 		return
 	}
 
@@ -103,25 +137,245 @@ func (h *UserAuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		Password: user.Password,
 	})
 
+	// Старые версии user_service сообщают о неверном пароле пустым токеном
+	if status.Code(err) == codes.Unauthenticated || (err == nil && loginResp.AccessToken == "") {
+		if h.loginFailed(w, r, user.Username, ip) {
+			return
+		}
+		if err != nil {
+			h.handleGRPCError(w, r, err)
+			return
+		}
+		problem.Write(w, r, http.StatusUnauthorized, "Invalid username or password")
+		return
+	}
+	if err != nil {
+		h.handleGRPCError(w, r, err)
+		return
+	}
+	h.loginSucceeded(r, user.Username)
+
+	// Токен user_service подтверждает только пароль: его срок не совпадает с AccessTTL,
+	// поэтому access токен выпускается здесь, так же как при обновлении
+	accessToken, err := h.tokens.AccessToken(loginResp.UserId, user.Username)
+	if err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+	familyID, err := h.tokens.FamilyID()
+	if err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+	refreshToken, err := h.tokens.RefreshToken()
+	if err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	expiresAt, err := h.writeAccessToken(r, loginResp.UserId, user.Username, accessToken, familyID)
+	if err != nil {
+		writeCacheError(w, r, err)
+		return
+	}
+
+	refreshResp, err := h.cache.WriteRefreshToken(r.Context(), &grpc_server.WriteRefreshTokenRequest{
+		UserId:       loginResp.UserId,
+		UserLogin:    user.Username,
+		RefreshToken: refreshToken,
+		FamilyId:     familyID,
+		TtlSeconds:   int64(h.tokens.RefreshTTL.Seconds()),
+	})
+	if err == nil && !refreshResp.Success {
+		err = errCacheRejected
+	}
+	if err != nil {
+		// Клиент получит ошибку, токенов у него не будет: сессия в кэше больше не нужна
+		h.revokeAccessToken(r, loginResp.UserId, accessToken)
+		writeCacheError(w, r, err)
+		return
+	}
+
+	h.writeTokens(w, accessToken, refreshToken, expiresAt)
+}
+
+// revokeAccessToken удаляет сессию незавершённого входа. Запрос клиента к этому
+// моменту может быть уже отменён, поэтому удаление идёт на отдельном контексте.
+func (h *UserAuthHandler) revokeAccessToken(r *http.Request, userID int32, accessToken string) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), revokeTimeout)
+	defer cancel()
+	_, err := h.cache.DeleteUser(ctx, &grpc_server.DeleteUserRequest{
+		UserId: userID,
+		JwtKey: accessToken,
+	})
+	if err != nil {
+		slog.WarnContext(r.Context(), "Error revoking session of failed login", slog.Any("error", err))
+	}
+}
+
+// RefreshToken меняет refresh токен на новую пару токенов. Каждый refresh токен одноразовый:
+// повторное предъявление отзывает всё семейство, включая выданные по нему access токены.
+func (h *UserAuthHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Content-Type") != "application/json" {
+		problem.Write(w, r, http.StatusUnsupportedMediaType, "Content-Type must be application/json")
+		return
+	}
+
+	var req struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.RefreshToken == "" {
+		problem.Write(w, r, http.StatusBadRequest, "refresh_token is required")
+		return
+	}
+
+	newRefreshToken, err := h.tokens.RefreshToken()
+	if err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	rotated, err := h.cache.RotateRefreshToken(r.Context(), &grpc_server.RotateRefreshTokenRequest{
+		RefreshToken:    req.RefreshToken,
+		NewRefreshToken: newRefreshToken,
+		TtlSeconds:      int64(h.tokens.RefreshTTL.Seconds()),
+	})
+	if err != nil {
+		problem.WriteGRPC(w, r, err, "Error in server cache")
+		return
+	}
+	if rotated.Reused {
+		auth.Challenge(w, auth.ErrCodeInvalidToken, "The refresh token has already been used", "")
+		problem.Write(w, r, http.StatusUnauthorized, "Refresh token reuse detected, session revoked")
+		return
+	}
+	if !rotated.Success {
+		auth.Challenge(w, auth.ErrCodeInvalidToken, "The refresh token is invalid or expired", "")
+		problem.Write(w, r, http.StatusUnauthorized, "Invalid refresh token")
+		return
+	}
+
+	accessToken, err := h.tokens.AccessToken(rotated.UserId, rotated.UserLogin)
+	if err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+	expiresAt, err := h.writeAccessToken(r, rotated.UserId, rotated.UserLogin, accessToken, rotated.FamilyId)
 	if err != nil {
-		h.handleGRPCError(w, err)
+		writeCacheError(w, r, err)
+		return
+	}
+
+	h.writeTokens(w, accessToken, newRefreshToken, expiresAt)
+}
+
+// errCacheRejected - cache_service ответил без ошибки, но запись не выполнил
+var errCacheRejected = errors.New("cache write rejected")
+
+// writeCacheError отвечает на неудачную запись токена: занятый ключ - 409,
+// недоступный cache_service - 503, остальное по коду gRPC ошибки
+func writeCacheError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, errCacheRejected) {
+		problem.Write(w, r, http.StatusBadGateway, "Error to write to cache")
 		return
 	}
+	problem.WriteGRPC(w, r, err, "Error to write to cache")
+}
 
+// writeAccessToken создаёт сессию в cache_service и возвращает, когда она истечёт:
+// по сроку токена или раньше, если cache_service ограничил сессию idle timeout
+func (h *UserAuthHandler) writeAccessToken(r *http.Request, userID int32, login, accessToken, familyID string) (time.Time, error) {
+	ttl := int64(h.tokens.AccessTTL.Seconds())
+	userAgent := r.UserAgent()
+	ip := clientip.FromRequest(r)
 	resp, err := h.cache.Write(r.Context(), &grpc_server.WriteRequest{
-		UserId:    loginResp.UserId,
-		UserLogin: user.Username,
-		JwtKey:    loginResp.AccessToken,
+		UserId:     userID,
+		UserLogin:  login,
+		JwtKey:     accessToken,
+		TtlSeconds: &ttl,
+		FamilyId:   &familyID,
+		UserAgent:  &userAgent,
+		Ip:         &ip,
 	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !resp.Success {
+		return time.Time{}, errCacheRejected
+	}
+	expiresAt := time.Now().Add(h.tokens.AccessTTL)
+	if resp.ExpiresAt > 0 && resp.ExpiresAt < expiresAt.Unix() {
+		expiresAt = time.Unix(resp.ExpiresAt, 0)
+	}
+	return expiresAt, nil
+}
 
-	if err != nil || !resp.Success {
-		http.Error(w, "Error to write to cache", http.StatusConflict)
+func (h *UserAuthHandler) writeTokens(w http.ResponseWriter, accessToken, refreshToken string, expiresAt time.Time) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token":  accessToken,
+		"refresh_token": refreshToken,
+		"token_type":    "Bearer",
+		"expires_in":    int64(time.Until(expiresAt).Round(time.Second).Seconds()),
+		"expires_at":    expiresAt.UTC().Format(time.RFC3339),
+	})
+}
+
+// Logout отзывает текущий jwt ключ
+func (h *UserAuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	userID := principal.UserID
+	jwtKey := principal.Token
+
+	resp, err := h.cache.DeleteUser(r.Context(), &grpc_server.DeleteUserRequest{
+		UserId: userID,
+		JwtKey: jwtKey,
+	})
+	if err != nil {
+		problem.WriteGRPC(w, r, err, "Error to delete from cache")
+		return
+	}
+	if !resp.Success {
+		problem.Write(w, r, http.StatusBadGateway, "Error to delete from cache")
+		return
+	}
+	h.authn.Forget(jwtKey)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+	})
+}
+
+// LogoutAll отзывает все jwt ключи пользователя (выход со всех устройств)
+func (h *UserAuthHandler) LogoutAll(w http.ResponseWriter, r *http.Request) {
+	principal, ok := requirePrincipal(w, r)
+	if !ok {
+		return
+	}
+	userID := principal.UserID
+
+	resp, err := h.cache.DeleteUserSessions(r.Context(), &grpc_server.DeleteUserSessionsRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		problem.WriteGRPC(w, r, err, "Error to delete from cache")
+		return
+	}
+	if !resp.Success {
+		problem.Write(w, r, http.StatusBadGateway, "Error to delete from cache")
 		return
 	}
+	h.authn.ForgetUser(userID)
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
-		"access_token": loginResp.AccessToken,
+		"success": true,
+		"revoked": resp.Deleted,
 	})
 }
 
@@ -154,23 +408,23 @@ func (h *UserAuthHandler) Crash(w http.ResponseWriter, r *http.Request) {
 	panic("Simulated crash for AlertManager testing")
 }
 
-func (h *UserAuthHandler) handleGRPCError(w http.ResponseWriter, err error) {
-	// Здесь можно добавить обработку различных gRPC ошибок
-	// и преобразование их в соответствующие HTTP статусы
-	http.Error(w, "Internal server error", http.StatusInternalServerError)
+func (h *UserAuthHandler) handleGRPCError(w http.ResponseWriter, r *http.Request, err error) {
+	problem.WriteGRPC(w, r, err, "Internal server error")
 }
 
 func (h *UserAuthHandler) Close() error {
 	return h.Client.Close()
 }
 
-func NewUserAuthHandler(addr string, chc *grpccache.CacheClient) (*UserAuthHandler, error) {
-	user_service_client, err := grpcclient.NewUserServiceClient("user_service:50051")
+func NewUserAuthHandler(addr string, chc *grpccache.CacheClient, tokens *auth.TokenIssuer, authn *auth.Authenticator, opts ...grpc.DialOption) (*UserAuthHandler, error) {
+	user_service_client, err := grpcclient.NewUserServiceClient(addr, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &UserAuthHandler{
 		Client: user_service_client,
 		cache:  chc,
+		tokens: tokens,
+		authn:  authn,
 	}, nil
 }
@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"api_service/internal/auth"
+	"api_service/internal/grpc/grpc_server"
+	"api_service/internal/grpc/server/user_grpc"
+	grpccache "api_service/internal/grpc_cache"
+	grpcclient "api_service/internal/grpc_client"
+	"api_service/internal/problem"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeRefreshCache отвечает на ротацию rotateResp (по умолчанию - успех),
+// а на запись сессии - writeResp и writeErr
+type fakeRefreshCache struct {
+	grpc_server.CacheServiceClient
+	rotateResp *grpc_server.RotateRefreshTokenResponse
+	writeResp  *grpc_server.WriteResponse
+	writeErr   error
+}
+
+func (f *fakeRefreshCache) RotateRefreshToken(_ context.Context, _ *grpc_server.RotateRefreshTokenRequest, _ ...grpc.CallOption) (
+	*grpc_server.RotateRefreshTokenResponse, error,
+) {
+	if f.rotateResp != nil {
+		return f.rotateResp, nil
+	}
+	return &grpc_server.RotateRefreshTokenResponse{Success: true, UserId: 7, UserLogin: "alice", FamilyId: "fam"}, nil
+}
+
+func (f *fakeRefreshCache) Write(_ context.Context, _ *grpc_server.WriteRequest, _ ...grpc.CallOption) (
+	*grpc_server.WriteResponse, error,
+) {
+	return f.writeResp, f.writeErr
+}
+
+func TestRefreshTokenCacheErrors(t *testing.T) {
+	keys, err := auth.ParseKeySet("k", "k:secret")
+	require.NoError(t, err)
+	tokens := auth.NewTokenIssuer(keys, "case_champion", time.Minute, time.Hour)
+
+	tests := []struct {
+		name string
+		fake *fakeRefreshCache
+		want int
+	}{
+		{"conflict", &fakeRefreshCache{writeErr: status.Error(codes.AlreadyExists, "Is already in redis")}, http.StatusConflict},
+		{"unavailable", &fakeRefreshCache{writeErr: status.Error(codes.Unavailable, "connection refused")}, http.StatusServiceUnavailable},
+		{"deadline", &fakeRefreshCache{writeErr: status.Error(codes.DeadlineExceeded, "timeout")}, http.StatusGatewayTimeout},
+		{"rejected", &fakeRefreshCache{writeResp: &grpc_server.WriteResponse{Success: false}}, http.StatusBadGateway},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &UserAuthHandler{cache: &grpccache.CacheClient{Client: tt.fake}, tokens: tokens}
+			r := httptest.NewRequest(http.MethodPost, "/refresh", strings.NewReader(`{"refresh_token":"rt"}`))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			h.RefreshToken(w, r)
+
+			assert.Equal(t, tt.want, w.Code)
+			assert.Equal(t, problem.ContentType, w.Header().Get("Content-Type"))
+		})
+	}
+}
+
+func TestRefreshTokenRejected(t *testing.T) {
+	keys, err := auth.ParseKeySet("k", "k:secret")
+	require.NoError(t, err)
+	tokens := auth.NewTokenIssuer(keys, "case_champion", time.Minute, time.Hour)
+
+	for name, resp := range map[string]*grpc_server.RotateRefreshTokenResponse{
+		"invalid": {Success: false},
+		"reused":  {Success: false, Reused: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			h := &UserAuthHandler{cache: &grpccache.CacheClient{Client: &fakeRefreshCache{rotateResp: resp}}, tokens: tokens}
+			r := httptest.NewRequest(http.MethodPost, "/refresh", strings.NewReader(`{"refresh_token":"rt"}`))
+			r.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			h.RefreshToken(w, r)
+
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+			assert.Equal(t, problem.ContentType, w.Header().Get("Content-Type"))
+			assert.Contains(t, w.Header().Get("WWW-Authenticate"), `error="invalid_token"`)
+		})
+	}
+}
+
+// fakeUserClient пускает любого пользователя с id 7
+type fakeUserClient struct {
+	user_grpc.UserServiceClient
+}
+
+func (fakeUserClient) Login(_ context.Context, _ *user_grpc.LoginRequest, _ ...grpc.CallOption) (
+	*user_grpc.LoginResponse, error,
+) {
+	return &user_grpc.LoginResponse{AccessToken: "user-service-token", UserId: 7}, nil
+}
+
+// fakeLoginCache создаёт сессию, но не может записать refresh токен
+type fakeLoginCache struct {
+	grpc_server.CacheServiceClient
+	written string
+	deleted *grpc_server.DeleteUserRequest
+}
+
+func (f *fakeLoginCache) CheckLogin(_ context.Context, _ *grpc_server.LoginAttemptRequest, _ ...grpc.CallOption) (
+	*grpc_server.LoginAttemptResponse, error,
+) {
+	return &grpc_server.LoginAttemptResponse{}, nil
+}
+
+func (f *fakeLoginCache) LoginSucceeded(_ context.Context, _ *grpc_server.LoginAttemptRequest, _ ...grpc.CallOption) (
+	*grpc_server.LoginAttemptResponse, error,
+) {
+	return &grpc_server.LoginAttemptResponse{}, nil
+}
+
+func (f *fakeLoginCache) Write(_ context.Context, req *grpc_server.WriteRequest, _ ...grpc.CallOption) (
+	*grpc_server.WriteResponse, error,
+) {
+	f.written = req.JwtKey
+	return &grpc_server.WriteResponse{Success: true}, nil
+}
+
+func (f *fakeLoginCache) WriteRefreshToken(_ context.Context, _ *grpc_server.WriteRefreshTokenRequest, _ ...grpc.CallOption) (
+	*grpc_server.WriteRefreshTokenResponse, error,
+) {
+	return nil, status.Error(codes.Unavailable, "connection refused")
+}
+
+func (f *fakeLoginCache) DeleteUser(_ context.Context, req *grpc_server.DeleteUserRequest, _ ...grpc.CallOption) (
+	*grpc_server.DeleteUserResponse, error,
+) {
+	f.deleted = req
+	return &grpc_server.DeleteUserResponse{Success: true}, nil
+}
+
+func TestLoginRevokesSessionWhenRefreshWriteFails(t *testing.T) {
+	keys, err := auth.ParseKeySet("k", "k:secret")
+	require.NoError(t, err)
+	fake := &fakeLoginCache{}
+	h := &UserAuthHandler{
+		Client: &grpcclient.UserServiceClient{Client: fakeUserClient{}},
+		cache:  &grpccache.CacheClient{Client: fake},
+		tokens: auth.NewTokenIssuer(keys, "case_champion", time.Minute, time.Hour),
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(`{"username":"alice","password":"x"}`))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	h.Login(w, r)
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	require.NotNil(t, fake.deleted)
+	assert.Equal(t, fake.written, fake.deleted.JwtKey)
+	assert.Equal(t, int32(7), fake.deleted.UserId)
+}
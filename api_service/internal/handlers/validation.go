@@ -0,0 +1,16 @@
+package handlers
+
+import (
+	taskclient "api_service/internal/grpc_task/task_client"
+	"api_service/internal/problem"
+	"net/http"
+)
+
+// validateRequest проверяет запрос к task_service до отправки и отвечает 400 с ошибками полей
+func validateRequest(w http.ResponseWriter, r *http.Request, req any) bool {
+	if err := taskclient.Validate(req); err != nil {
+		problem.WriteGRPC(w, r, err, "Invalid request")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,169 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+// Check - проверка одной зависимости
+type Check struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// CheckResult - результат проверки для JSON ответа
+type CheckResult struct {
+	Status     string  `json:"status"`
+	Error      string  `json:"error,omitempty"`
+	DurationMs float64 `json:"duration_ms"`
+}
+
+// Report - ответ /readyz
+type Report struct {
+	Status    string                 `json:"status"`
+	CheckedAt time.Time              `json:"checked_at"`
+	Checks    map[string]CheckResult `json:"checks,omitempty"`
+}
+
+// Checker выполняет проверки параллельно с таймаутом и кэширует результат,
+// чтобы частые пробы не нагружали зависимости
+type Checker struct {
+	readiness *Readiness
+	checks    []Check
+	timeout   time.Duration
+	cacheTTL  time.Duration
+
+	mu     sync.Mutex
+	last   Report
+	lastAt time.Time
+}
+
+func NewChecker(readiness *Readiness, timeout, cacheTTL time.Duration, checks ...Check) *Checker {
+	return &Checker{
+		readiness: readiness,
+		checks:    checks,
+		timeout:   timeout,
+		cacheTTL:  cacheTTL,
+	}
+}
+
+// Run возвращает результат проверок, не старше cacheTTL. Проверки идут на
+// контексте, отвязанном от пробы: отменённая проба не должна записать в кэш
+// отказ, который получат следующие /readyz.
+func (c *Checker) Run(ctx context.Context) Report {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.lastAt.IsZero() && time.Since(c.lastAt) < c.cacheTTL {
+		return c.last
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.timeout)
+	defer cancel()
+
+	results := make([]CheckResult, len(c.checks))
+	canceled := make([]bool, len(c.checks))
+	var wg sync.WaitGroup
+	for i, check := range c.checks {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			start := time.Now()
+			err := check.Check(ctx)
+			results[i] = CheckResult{
+				Status:     StatusOK,
+				DurationMs: float64(time.Since(start).Microseconds()) / 1000,
+			}
+			if err != nil {
+				results[i].Status = StatusFail
+				results[i].Error = err.Error()
+				canceled[i] = errors.Is(err, context.Canceled) || status.Code(err) == codes.Canceled
+			}
+		}(i, check)
+	}
+	wg.Wait()
+
+	report := Report{
+		Status:    StatusOK,
+		CheckedAt: time.Now().UTC(),
+		Checks:    make(map[string]CheckResult, len(c.checks)),
+	}
+	cache := true
+	for i, check := range c.checks {
+		report.Checks[check.Name] = results[i]
+		if results[i].Status != StatusOK {
+			report.Status = StatusFail
+		}
+		// Отмена говорит о вызывающем, а не о зависимости: такой отчёт не кэшируется
+		if canceled[i] {
+			cache = false
+		}
+	}
+	if cache {
+		c.last, c.lastAt = report, time.Now()
+	}
+	return report
+}
+
+// LivezHandler - процесс жив; зависимости не проверяются, чтобы их отказ не
+// приводил к перезапуску сервиса
+func (c *Checker) LivezHandler(w http.ResponseWriter, _ *http.Request) {
+	writeReport(w, http.StatusOK, Report{Status: StatusOK, CheckedAt: time.Now().UTC()})
+}
+
+// ReadyzHandler - 200, если сервис не останавливается и все зависимости доступны
+func (c *Checker) ReadyzHandler(w http.ResponseWriter, r *http.Request) {
+	if !c.readiness.Ready() {
+		writeReport(w, http.StatusServiceUnavailable, Report{
+			Status:    StatusFail,
+			CheckedAt: time.Now().UTC(),
+			Checks:    map[string]CheckResult{"shutdown": {Status: StatusFail, Error: "draining"}},
+		})
+		return
+	}
+	report := c.Run(r.Context())
+	code := http.StatusOK
+	if report.Status != StatusOK {
+		code = http.StatusServiceUnavailable
+	}
+	writeReport(w, code, report)
+}
+
+func writeReport(w http.ResponseWriter, code int, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(report)
+}
+
+// GRPC проверяет зависимость через grpc.health.v1. Сервис без Health (Unimplemented)
+// считается доступным: он ответил, значит соединение работает.
+func GRPC(conn *grpc.ClientConn, service string) func(ctx context.Context) error {
+	client := healthpb.NewHealthClient(conn)
+	return func(ctx context.Context) error {
+		resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		if status.Code(err) == codes.Unimplemented {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			return status.Errorf(codes.Unavailable, "status %s", resp.GetStatus())
+		}
+		return nil
+	}
+}
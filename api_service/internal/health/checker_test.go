@@ -0,0 +1,138 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func readyz(c *Checker) (int, Report) {
+	w := httptest.NewRecorder()
+	c.ReadyzHandler(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	var report Report
+	json.NewDecoder(w.Body).Decode(&report)
+	return w.Code, report
+}
+
+func TestReadyz(t *testing.T) {
+	var fail atomic.Bool
+	readiness := NewReadiness()
+	checker := NewChecker(readiness, time.Second, 0,
+		Check{Name: "ok", Check: func(ctx context.Context) error { return nil }},
+		Check{Name: "flaky", Check: func(ctx context.Context) error {
+			if fail.Load() {
+				return errors.New("connection refused")
+			}
+			return nil
+		}},
+	)
+
+	code, report := readyz(checker)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, StatusOK, report.Status)
+	assert.Len(t, report.Checks, 2)
+
+	fail.Store(true)
+	code, report = readyz(checker)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, StatusOK, report.Checks["ok"].Status)
+	assert.Equal(t, "connection refused", report.Checks["flaky"].Error)
+
+	fail.Store(false)
+	readiness.SetDraining()
+	code, report = readyz(checker)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "draining", report.Checks["shutdown"].Error)
+}
+
+func TestCheckerTimeoutAndCache(t *testing.T) {
+	var calls atomic.Int32
+	checker := NewChecker(NewReadiness(), 20*time.Millisecond, time.Minute,
+		Check{Name: "slow", Check: func(ctx context.Context) error {
+			calls.Add(1)
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+	)
+
+	report := checker.Run(context.Background())
+	assert.Equal(t, StatusFail, report.Status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks["slow"].Error)
+
+	checker.Run(context.Background())
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestCheckerIgnoresProbeCancel(t *testing.T) {
+	var calls atomic.Int32
+	var cancelCheck atomic.Bool
+	checker := NewChecker(NewReadiness(), time.Second, time.Minute,
+		Check{Name: "dep", Check: func(ctx context.Context) error {
+			calls.Add(1)
+			if cancelCheck.Load() {
+				return context.Canceled
+			}
+			return ctx.Err()
+		}},
+	)
+
+	// Проба отменена до начала проверки, зависимость при этом доступна
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	report := checker.Run(ctx)
+	assert.Equal(t, StatusOK, report.Status)
+
+	// Отменённая проверка не попадает в кэш
+	checker = NewChecker(NewReadiness(), time.Second, time.Minute, checker.checks...)
+	cancelCheck.Store(true)
+	assert.Equal(t, StatusFail, checker.Run(context.Background()).Status)
+	cancelCheck.Store(false)
+	assert.Equal(t, StatusOK, checker.Run(context.Background()).Status)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestGRPCCheck(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	hs := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(srv, hs)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	check := GRPC(conn, "")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.NoError(t, check(ctx))
+
+	hs.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	assert.Error(t, check(ctx))
+
+	// Сервер без Health считается доступным
+	bare := grpc.NewServer()
+	bareLis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go bare.Serve(bareLis)
+	defer bare.Stop()
+	bareConn, err := grpc.NewClient(bareLis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer bareConn.Close()
+	assert.NoError(t, GRPC(bareConn, "")(ctx))
+}
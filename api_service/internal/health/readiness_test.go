@@ -0,0 +1,27 @@
+package health
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadinessDraining(t *testing.T) {
+	r := NewReadiness()
+
+	serve := func(h http.HandlerFunc) int {
+		w := httptest.NewRecorder()
+		h(w, httptest.NewRequest(http.MethodGet, "/", nil))
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, serve(r.HealthHandler))
+	assert.Equal(t, http.StatusOK, serve(r.ReadyHandler))
+
+	r.SetDraining()
+	assert.False(t, r.Ready())
+	assert.Equal(t, http.StatusOK, serve(r.HealthHandler))
+	assert.Equal(t, http.StatusServiceUnavailable, serve(r.ReadyHandler))
+}
@@ -0,0 +1,134 @@
+// Package httplog - request ID и access log для HTTP сервера
+package httplog
+
+import (
+	"api_service/internal/clientip"
+	"fmt"
+	"grpcmw"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// RequestIDHeader - заголовок, в котором клиент и прокси передают request ID
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLen ограничивает длину чужого request ID, чтобы он не раздувал логи
+const maxRequestIDLen = 128
+
+// RequestID берёт request ID из заголовка X-Request-ID или генерирует новый.
+// ID возвращается в ответе и кладётся в контекст, откуда gRPC клиенты
+// передают его в metadata вызовов к cache, user и task сервисам.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if !validRequestID(id) {
+			id = grpcmw.NewRequestID()
+		}
+		w.Header().Set(RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(grpcmw.WithRequestID(r.Context(), id)))
+	})
+}
+
+// validRequestID пропускает только печатные ASCII символы без пробелов
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// entry - данные одного запроса для access log
+type entry struct {
+	start     time.Time
+	method    string
+	route     string
+	uri       string
+	proto     string
+	remote    string
+	status    int
+	bytes     int
+	duration  time.Duration
+	requestID string
+	userAgent string
+}
+
+// AccessLog пишет строку access log на каждый запрос: json - через logger,
+// common - в out в Common Log Format. С форматом off middleware ничего не делает.
+func AccessLog(format string, logger *slog.Logger, out io.Writer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if format == "off" {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			next.ServeHTTP(ww, r)
+
+			e := entry{
+				start:     start,
+				method:    r.Method,
+				uri:       r.RequestURI,
+				proto:     r.Proto,
+				remote:    clientip.FromRequest(r),
+				status:    ww.Status(),
+				bytes:     ww.BytesWritten(),
+				duration:  time.Since(start),
+				requestID: grpcmw.RequestID(r.Context()),
+				userAgent: r.UserAgent(),
+			}
+			if e.status == 0 {
+				e.status = http.StatusOK
+			}
+			if rctx := chi.RouteContext(r.Context()); rctx != nil {
+				e.route = rctx.RoutePattern()
+			}
+
+			if format == "common" {
+				writeCommon(out, e)
+				return
+			}
+			logJSON(r, logger, e)
+		})
+	}
+}
+
+func logJSON(r *http.Request, logger *slog.Logger, e entry) {
+	level := slog.LevelInfo
+	if e.status >= http.StatusInternalServerError {
+		level = slog.LevelError
+	}
+	logger.LogAttrs(r.Context(), level, "http request",
+		slog.String("method", e.method),
+		slog.String("route", e.route),
+		slog.String("uri", e.uri),
+		slog.Int("status", e.status),
+		slog.Int("bytes", e.bytes),
+		slog.Float64("duration_ms", float64(e.duration.Microseconds())/1000),
+		slog.String("remote", e.remote),
+		slog.String("user_agent", e.userAgent),
+		slog.String("request_id", e.requestID),
+	)
+}
+
+// writeCommon пишет запись в Common Log Format:
+// host ident authuser [date] "request" status bytes
+func writeCommon(out io.Writer, e entry) {
+	size := "-"
+	if e.bytes > 0 {
+		size = strconv.Itoa(e.bytes)
+	}
+	fmt.Fprintf(out, "%s - - [%s] \"%s %s %s\" %d %s\n",
+		e.remote, e.start.Format("02/Jan/2006:15:04:05 -0700"),
+		e.method, e.uri, e.proto, e.status, size)
+}
@@ -0,0 +1,78 @@
+package httplog
+
+import (
+	"bytes"
+	"encoding/json"
+	"grpcmw"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRouter(format string, logger *slog.Logger, out *bytes.Buffer) *chi.Mux {
+	r := chi.NewRouter()
+	r.Use(RequestID)
+	r.Use(AccessLog(format, logger, out))
+	r.Get("/tasks/{taskID}", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(grpcmw.RequestID(r.Context())))
+	})
+	return r
+}
+
+func TestRequestIDPassedThrough(t *testing.T) {
+	r := newRouter("off", nil, nil)
+	req := httptest.NewRequest(http.MethodGet, "/tasks/1", nil)
+	req.Header.Set(RequestIDHeader, "abc-123")
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	assert.Equal(t, "abc-123", rec.Header().Get(RequestIDHeader))
+	assert.Equal(t, "abc-123", rec.Body.String())
+}
+
+func TestRequestIDGenerated(t *testing.T) {
+	r := newRouter("off", nil, nil)
+	for _, id := range []string{"", "has space", strings.Repeat("x", maxRequestIDLen+1)} {
+		req := httptest.NewRequest(http.MethodGet, "/tasks/1", nil)
+		req.Header.Set(RequestIDHeader, id)
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+
+		got := rec.Header().Get(RequestIDHeader)
+		assert.Regexp(t, `^[0-9a-f]{32}$`, got)
+		assert.Equal(t, got, rec.Body.String())
+	}
+}
+
+func TestAccessLogJSON(t *testing.T) {
+	var buf bytes.Buffer
+	r := newRouter("json", slog.New(slog.NewJSONHandler(&buf, nil)), nil)
+	req := httptest.NewRequest(http.MethodGet, "/tasks/7?x=1", nil)
+	req.Header.Set(RequestIDHeader, "req-1")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	var rec map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &rec))
+	assert.Equal(t, "http request", rec["msg"])
+	assert.Equal(t, "GET", rec["method"])
+	assert.Equal(t, "/tasks/{taskID}", rec["route"])
+	assert.Equal(t, "/tasks/7?x=1", rec["uri"])
+	assert.Equal(t, float64(200), rec["status"])
+	assert.Equal(t, float64(len("req-1")), rec["bytes"])
+	assert.Equal(t, "req-1", rec["request_id"])
+	assert.Equal(t, "192.0.2.1", rec["remote"])
+}
+
+func TestAccessLogCommon(t *testing.T) {
+	var buf bytes.Buffer
+	r := newRouter("common", nil, &buf)
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/missing", nil))
+
+	assert.Regexp(t, `^192\.0\.2\.1 - - \[[^\]]+\] "GET /missing HTTP/1\.1" 404 \d+\n$`, buf.String())
+}
@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// unmatchedRoute - метка для запросов мимо роутов, чтобы сканеры не раздували кардинальность
+const unmatchedRoute = "unmatched"
+
+// Middleware записывает метрики запроса. Путь берётся из шаблона роута chi
+// (/tasks/{taskID}), а не из URL, поэтому число серий ограничено числом роутов.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		httpRequestsInFlight.Inc()
+		defer httpRequestsInFlight.Dec()
+
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+
+		path := unmatchedRoute
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+			path = rctx.RoutePattern()
+		}
+		code := ww.Status()
+		if code == 0 {
+			code = http.StatusOK
+		}
+
+		httpRequestsTotal.WithLabelValues(r.Method, path, strconv.Itoa(code)).Inc()
+		httpRequestDuration.WithLabelValues(r.Method, path).Observe(time.Since(start).Seconds())
+		// Для chunked тела размер неизвестен (-1), считаем его нулевым
+		httpRequestSize.WithLabelValues(r.Method, path).Observe(float64(max(r.ContentLength, 0)))
+		httpResponseSize.WithLabelValues(r.Method, path).Observe(float64(ww.BytesWritten()))
+	})
+}
@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMiddleware(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(Middleware)
+	r.Post("/tasks/{taskID}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, float64(1), testutil.ToFloat64(httpRequestsInFlight))
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte("created"))
+	})
+
+	for _, id := range []string{"1", "2", "3"} {
+		req := httptest.NewRequest(http.MethodPost, "/tasks/"+id, strings.NewReader(`{"title":"x"}`))
+		r.ServeHTTP(httptest.NewRecorder(), req)
+	}
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/wp-admin", nil))
+
+	// Все три запроса попадают в одну серию по шаблону роута
+	assert.Equal(t, float64(3), testutil.ToFloat64(httpRequestsTotal.WithLabelValues("POST", "/tasks/{taskID}", "201")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(httpRequestsTotal.WithLabelValues("GET", unmatchedRoute, "404")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(httpRequestsInFlight))
+	assert.Equal(t, 2, testutil.CollectAndCount(httpResponseSize))
+	assert.Equal(t, 2, testutil.CollectAndCount(httpRequestDuration))
+}
+
+func TestCacheOperation(t *testing.T) {
+	CacheOperation("GetUser", nil)
+	CacheOperation("GetUser", status.Error(codes.Unavailable, "down"))
+	CacheOperation("GetUser", errors.New("plain"))
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(cacheOperations.WithLabelValues("GetUser", "OK")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(cacheOperations.WithLabelValues("GetUser", "Unavailable")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(cacheOperations.WithLabelValues("GetUser", "Unknown")))
+}
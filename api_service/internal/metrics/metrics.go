@@ -0,0 +1,75 @@
+// Package metrics - метрики Prometheus api_service
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	httpRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Total HTTP requests",
+		},
+		[]string{"method", "path", "status"},
+	)
+
+	httpRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "HTTP request duration",
+			Buckets: []float64{0.01, 0.05, 0.1, 0.3, 1, 3, 5},
+		},
+		[]string{"method", "path"},
+	)
+
+	httpRequestsInFlight = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "http_requests_in_flight",
+			Help: "HTTP requests being served",
+		},
+	)
+
+	httpRequestSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "http_request_size_bytes",
+			Help:    "HTTP request body size",
+			Buckets: prometheus.ExponentialBuckets(64, 4, 7),
+		},
+		[]string{"method", "path"},
+	)
+
+	httpResponseSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "http_response_size_bytes",
+			Help:    "HTTP response body size",
+			Buckets: prometheus.ExponentialBuckets(64, 4, 7),
+		},
+		[]string{"method", "path"},
+	)
+
+	cacheOperations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_operations_total",
+			Help: "Total cache operations",
+		},
+		[]string{"operation", "status"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		httpRequestsTotal,
+		httpRequestDuration,
+		httpRequestsInFlight,
+		httpRequestSize,
+		httpResponseSize,
+		cacheOperations,
+	)
+}
+
+// CacheOperation учитывает вызов cache_service; status - код gRPC ответа
+func CacheOperation(operation string, err error) {
+	cacheOperations.WithLabelValues(operation, status.Code(err).String()).Inc()
+}
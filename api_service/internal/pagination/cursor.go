@@ -0,0 +1,66 @@
+// Package pagination реализует непрозрачные подписанные курсоры для списков
+package pagination
+
+import (
+	task_server "api_service/internal/grpc_task"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Signer подписывает курсоры, чтобы клиент не мог подставить произвольную позицию
+// или использовать курсор чужого списка
+type Signer struct {
+	key []byte
+}
+
+func NewSigner(key []byte) *Signer {
+	return &Signer{key: key}
+}
+
+func (s *Signer) mac(scope string, payload []byte) []byte {
+	m := hmac.New(sha256.New, s.key)
+	m.Write([]byte(scope))
+	m.Write([]byte{0})
+	m.Write(payload)
+	return m.Sum(nil)
+}
+
+// Encode превращает позицию в строку "<payload>.<hmac>". scope привязывает курсор
+// к списку и пользователю, например "tasks:42".
+func (s *Signer) Encode(c *task_server.Cursor, scope string) (string, error) {
+	payload, err := proto.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString(payload) + "." + enc.EncodeToString(s.mac(scope, payload)), nil
+}
+
+// Decode проверяет подпись и восстанавливает позицию
+func (s *Signer) Decode(cursor, scope string) (*task_server.Cursor, error) {
+	enc := base64.RawURLEncoding
+	p, sig, found := strings.Cut(cursor, ".")
+	if !found {
+		return nil, ErrInvalidCursor
+	}
+	payload, err := enc.DecodeString(p)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	mac, err := enc.DecodeString(sig)
+	if err != nil || !hmac.Equal(mac, s.mac(scope, payload)) {
+		return nil, ErrInvalidCursor
+	}
+	var c task_server.Cursor
+	if err := proto.Unmarshal(payload, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
@@ -0,0 +1,88 @@
+package pagination
+
+import (
+	task_server "api_service/internal/grpc_task"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	s := NewSigner([]byte("secret"))
+	c := &task_server.Cursor{SortValues: []string{"5", "2025-01-02T03:04:05"}, Id: 17}
+
+	encoded, err := s.Encode(c, "tasks:1")
+	require.NoError(t, err)
+
+	decoded, err := s.Decode(encoded, "tasks:1")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(c, decoded))
+}
+
+func TestCursorTampering(t *testing.T) {
+	s := NewSigner([]byte("secret"))
+	encoded, err := s.Encode(&task_server.Cursor{SortValues: []string{"5"}, Id: 17}, "tasks:1")
+	require.NoError(t, err)
+	forged, err := s.Encode(&task_server.Cursor{SortValues: []string{"5"}, Id: 1}, "tasks:2")
+	require.NoError(t, err)
+
+	payload, sig, _ := strings.Cut(encoded, ".")
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	tests := []struct {
+		name   string
+		signer *Signer
+		cursor string
+		scope  string
+	}{
+		{name: "other user", signer: s, cursor: encoded, scope: "tasks:2"},
+		{name: "other list", signer: s, cursor: encoded, scope: "folders:1"},
+		{name: "other key", signer: NewSigner([]byte("other")), cursor: encoded, scope: "tasks:1"},
+		{name: "swapped payload", signer: s, cursor: forgedPayload + "." + sig, scope: "tasks:1"},
+		{name: "no signature", signer: s, cursor: payload, scope: "tasks:1"},
+		{name: "broken payload", signer: s, cursor: payload + "!." + sig, scope: "tasks:1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.signer.Decode(tt.cursor, tt.scope)
+			assert.ErrorIs(t, err, ErrInvalidCursor)
+		})
+	}
+}
+
+func TestParseAndNext(t *testing.T) {
+	s := NewSigner([]byte("secret"))
+
+	_, err := s.Parse(httptest.NewRequest(http.MethodGet, "/tasks?limit=0", nil), "tasks:1")
+	assert.Error(t, err)
+	_, err = s.Parse(httptest.NewRequest(http.MethodGet, "/tasks?limit=101", nil), "tasks:1")
+	assert.Error(t, err)
+
+	page, err := s.Parse(httptest.NewRequest(http.MethodGet, "/tasks", nil), "tasks:1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(DefaultLimit), page.Limit)
+	assert.Nil(t, page.Cursor)
+
+	r := httptest.NewRequest(http.MethodGet, "/tasks?folder_id=3&limit=10", nil)
+	w := httptest.NewRecorder()
+	cursor, err := s.Next(w, r, &task_server.Cursor{SortValues: []string{"1"}, Id: 9}, "tasks:1", 10)
+	require.NoError(t, err)
+	assert.NotEmpty(t, cursor)
+	assert.Contains(t, w.Header().Get("Link"), "folder_id=3")
+	assert.Contains(t, w.Header().Get("Link"), `rel="next"`)
+
+	page, err = s.Parse(httptest.NewRequest(http.MethodGet, "/tasks?limit=10&cursor="+cursor, nil), "tasks:1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(9), page.Cursor.Id)
+
+	w = httptest.NewRecorder()
+	cursor, err = s.Next(w, r, nil, "tasks:1", 10)
+	require.NoError(t, err)
+	assert.Empty(t, cursor)
+	assert.Empty(t, w.Header().Get("Link"))
+}
@@ -0,0 +1,81 @@
+package pagination
+
+import (
+	task_server "api_service/internal/grpc_task"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	DefaultLimit = 50
+	MaxLimit     = 100
+)
+
+// Page - параметры limit и cursor из query
+type Page struct {
+	Limit  int32
+	Cursor *task_server.Cursor
+}
+
+// Parse читает limit и cursor из запроса; ошибка означает 400
+func (s *Signer) Parse(r *http.Request, scope string) (Page, error) {
+	page := Page{Limit: DefaultLimit}
+	q := r.URL.Query()
+
+	if v := q.Get("limit"); v != "" {
+		limit, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || limit < 1 || limit > MaxLimit {
+			return page, fmt.Errorf("limit must be an integer between 1 and %d", MaxLimit)
+		}
+		page.Limit = int32(limit)
+	}
+	if v := q.Get("cursor"); v != "" {
+		c, err := s.Decode(v, scope)
+		if err != nil {
+			return page, err
+		}
+		page.Cursor = c
+	}
+	return page, nil
+}
+
+// Scope привязывает курсор к пользователю, пути и всем параметрам запроса, кроме
+// limit и cursor: при смене фильтра или сортировки старый курсор недействителен
+func Scope(userID int32, r *http.Request) string {
+	q := url.Values{}
+	for k, v := range r.URL.Query() {
+		if k != "cursor" && k != "limit" {
+			q[k] = v
+		}
+	}
+	return fmt.Sprintf("%d:%s?%s", userID, r.URL.Path, q.Encode())
+}
+
+// Pagination - сообщение для запроса к task_service
+func (p Page) Pagination() *task_server.Pagination {
+	return &task_server.Pagination{
+		Limit: p.Limit,
+		After: p.Cursor,
+	}
+}
+
+// Next кодирует курсор следующей страницы и ставит заголовок Link с rel="next".
+// Возвращает пустую строку на последней странице.
+func (s *Signer) Next(w http.ResponseWriter, r *http.Request, next *task_server.Cursor, scope string, limit int32) (string, error) {
+	if next == nil {
+		return "", nil
+	}
+	cursor, err := s.Encode(next, scope)
+	if err != nil {
+		return "", err
+	}
+	u := *r.URL
+	q := u.Query()
+	q.Set("cursor", cursor)
+	q.Set("limit", strconv.Itoa(int(limit)))
+	u.RawQuery = q.Encode()
+	w.Header().Add("Link", fmt.Sprintf(`<%s>; rel="next"`, u.RequestURI()))
+	return cursor, nil
+}
@@ -0,0 +1,73 @@
+package problem
+
+import (
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StatusClientClosedRequest - нестандартный статус nginx для отменённого клиентом запроса
+const StatusClientClosedRequest = 499
+
+// HTTPStatus переводит gRPC код в HTTP статус
+func HTTPStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return StatusClientClosedRequest
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		// Unknown, Internal, DataLoss
+		return http.StatusInternalServerError
+	}
+}
+
+// FromGRPC строит Problem из ошибки gRPC вызова. Для 5xx текст ошибки сервиса
+// наружу не отдаётся, вместо него используется fallback.
+func FromGRPC(err error, fallback string) *Problem {
+	st := status.Convert(err)
+	code := HTTPStatus(st.Code())
+
+	detail := fallback
+	if code < http.StatusInternalServerError && st.Message() != "" {
+		detail = st.Message()
+	}
+	p := New(code, detail)
+
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				p.Errors = append(p.Errors, FieldViolation{
+					Field:       v.GetField(),
+					Description: v.GetDescription(),
+				})
+			}
+		}
+	}
+	return p
+}
+
+// WriteGRPC отправляет клиенту ошибку gRPC вызова
+func WriteGRPC(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	FromGRPC(err, fallback).Write(w, r)
+}
@@ -0,0 +1,69 @@
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHTTPStatus(t *testing.T) {
+	tests := map[codes.Code]int{
+		codes.NotFound:         http.StatusNotFound,
+		codes.InvalidArgument:  http.StatusBadRequest,
+		codes.AlreadyExists:    http.StatusConflict,
+		codes.PermissionDenied: http.StatusForbidden,
+		codes.Unauthenticated:  http.StatusUnauthorized,
+		codes.Unavailable:      http.StatusServiceUnavailable,
+		codes.DeadlineExceeded: http.StatusGatewayTimeout,
+		codes.Internal:         http.StatusInternalServerError,
+		codes.Unknown:          http.StatusInternalServerError,
+	}
+	for code, want := range tests {
+		assert.Equal(t, want, HTTPStatus(code), code.String())
+	}
+}
+
+func TestWriteGRPC(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "invalid task").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "title", Description: "value length must be between 1 and 100 runes"},
+		},
+	})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	WriteGRPC(w, httptest.NewRequest(http.MethodPost, "/tasks", nil), st.Err(), "Error creating task")
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, ContentType, w.Header().Get("Content-Type"))
+
+	var p Problem
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&p))
+	assert.Equal(t, Problem{
+		Type:     "about:blank",
+		Title:    "Bad Request",
+		Status:   http.StatusBadRequest,
+		Detail:   "invalid task",
+		Instance: "/tasks",
+		Errors: []FieldViolation{
+			{Field: "title", Description: "value length must be between 1 and 100 runes"},
+		},
+	}, p)
+}
+
+func TestFromGRPCHidesInternalDetails(t *testing.T) {
+	p := FromGRPC(status.Error(codes.Internal, "psycopg2.OperationalError: connection refused"), "Error getting task")
+	assert.Equal(t, http.StatusInternalServerError, p.Status)
+	assert.Equal(t, "Error getting task", p.Detail)
+
+	p = FromGRPC(errors.New("not a status"), "Error getting task")
+	assert.Equal(t, http.StatusInternalServerError, p.Status)
+}
@@ -0,0 +1,51 @@
+// Package problem отдаёт ошибки API в формате RFC 7807 (application/problem+json)
+package problem
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const ContentType = "application/problem+json"
+
+// FieldViolation - ошибка конкретного поля запроса
+type FieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// Problem - тело ответа об ошибке по RFC 7807
+type Problem struct {
+	Type     string           `json:"type"`
+	Title    string           `json:"title"`
+	Status   int              `json:"status"`
+	Detail   string           `json:"detail,omitempty"`
+	Instance string           `json:"instance,omitempty"`
+	Errors   []FieldViolation `json:"errors,omitempty"`
+}
+
+// New создаёт Problem с типом about:blank, title берётся из HTTP статуса
+func New(status int, detail string) *Problem {
+	return &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	}
+}
+
+// Write отправляет problem клиенту; instance по умолчанию - путь запроса
+func (p *Problem) Write(w http.ResponseWriter, r *http.Request) {
+	if p.Instance == "" && r != nil {
+		p.Instance = r.URL.Path
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
+
+// Write - сокращение для New(status, detail).Write(w, r)
+func Write(w http.ResponseWriter, r *http.Request, status int, detail string) {
+	New(status, detail).Write(w, r)
+}
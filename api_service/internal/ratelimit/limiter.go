@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"api_service/internal/auth"
+	"api_service/internal/clientip"
+	"api_service/internal/problem"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Limiter применяет политики к маршрутам. Nil Limiter ничего не ограничивает,
+// поэтому обработчики могут вызывать Handler без проверки, включён ли лимит.
+type Limiter struct {
+	store    Store
+	policies map[string]Policy
+	logger   *slog.Logger
+}
+
+func New(store Store, policies map[string]Policy, logger *slog.Logger) *Limiter {
+	return &Limiter{
+		store:    store,
+		policies: policies,
+		logger:   logger,
+	}
+}
+
+// Handler ограничивает запросы по политике name. Ключ бакета - пользователь из
+// AuthMiddleware, на анонимных маршрутах - IP клиента. Маршрут без политики не
+// ограничивается.
+func (l *Limiter) Handler(name string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if l == nil {
+			return next
+		}
+		p, ok := l.policies[name]
+		if !ok {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			res, err := l.store.Take(r.Context(), name+":"+clientKey(r), p)
+			if err != nil {
+				// Недоступный cache_service не должен останавливать API
+				l.logger.WarnContext(r.Context(), "Rate limiter unavailable, request allowed",
+					slog.String("policy", name), slog.Any("error", err))
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			h := w.Header()
+			h.Set("RateLimit-Limit", strconv.Itoa(p.Limit))
+			h.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+			h.Set("RateLimit-Reset", ceilSeconds(res.ResetAfter))
+			h.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%s", p.Limit, ceilSeconds(p.Period)))
+			if !res.Allowed {
+				h.Set("Retry-After", ceilSeconds(res.RetryAfter))
+				problem.Write(w, r, http.StatusTooManyRequests, "Rate limit exceeded, retry later")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func clientKey(r *http.Request) string {
+	if p, ok := auth.FromContext(r.Context()); ok {
+		return "user:" + strconv.Itoa(int(p.UserID))
+	}
+	return "ip:" + clientip.FromRequest(r)
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+}
@@ -0,0 +1,47 @@
+// Package ratelimit ограничивает частоту запросов к API алгоритмом token bucket.
+// Бакеты хранятся в памяти реплики или в cache_service, чтобы реплики делили квоту.
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Policy - до Limit запросов подряд, бакет полностью восстанавливается за Period
+type Policy struct {
+	Limit  int
+	Period time.Duration
+}
+
+// Rate - сколько токенов добавляется в секунду
+func (p Policy) Rate() float64 {
+	return float64(p.Limit) / p.Period.Seconds()
+}
+
+// ParsePolicies разбирает строку вида "login=10/1m,search=30/1m"
+func ParsePolicies(spec string) (map[string]Policy, error) {
+	policies := map[string]Policy{}
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(item, "=")
+		limit, period, ok2 := strings.Cut(value, "/")
+		if !ok || !ok2 || name == "" {
+			return nil, fmt.Errorf("invalid rate limit policy %q, expected name=requests/period", item)
+		}
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid rate limit policy %q: requests must be a positive integer", item)
+		}
+		d, err := time.ParseDuration(period)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid rate limit policy %q: period must be a positive duration", item)
+		}
+		policies[name] = Policy{Limit: n, Period: d}
+	}
+	return policies, nil
+}
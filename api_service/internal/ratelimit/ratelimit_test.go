@@ -0,0 +1,123 @@
+package ratelimit
+
+import (
+	"api_service/internal/auth"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePolicies(t *testing.T) {
+	policies, err := ParsePolicies("login=10/1m, search=30/30s,")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Policy{
+		"login":  {Limit: 10, Period: time.Minute},
+		"search": {Limit: 30, Period: 30 * time.Second},
+	}, policies)
+	assert.Equal(t, 1.0, policies["search"].Rate())
+
+	for _, spec := range []string{"login", "login=10", "=10/1m", "login=0/1m", "login=x/1m", "login=10/0s", "login=10/soon"} {
+		_, err := ParsePolicies(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	s := NewMemoryStore()
+	s.now = func() time.Time { return now }
+	p := Policy{Limit: 2, Period: 2 * time.Second}
+	ctx := context.Background()
+
+	res, _ := s.Take(ctx, "k", p)
+	assert.Equal(t, Result{Allowed: true, Remaining: 1, ResetAfter: time.Second}, res)
+	res, _ = s.Take(ctx, "k", p)
+	assert.True(t, res.Allowed)
+	res, _ = s.Take(ctx, "k", p)
+	assert.Equal(t, Result{Allowed: false, RetryAfter: time.Second, ResetAfter: 2 * time.Second}, res)
+
+	now = now.Add(500 * time.Millisecond)
+	res, _ = s.Take(ctx, "k", p)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 500*time.Millisecond, res.RetryAfter)
+
+	// Полные бакеты удаляются при очистке
+	now = now.Add(sweepInterval)
+	res, _ = s.Take(ctx, "other", p)
+	assert.True(t, res.Allowed)
+	assert.Len(t, s.buckets, 1)
+}
+
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, Policy) (Result, error) {
+	return Result{}, errors.New("cache_service unavailable")
+}
+
+func newTestHandler(l *Limiter, policy string) http.Handler {
+	return l.Handler(policy)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+}
+
+func TestHandler(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	l := New(NewMemoryStore(), map[string]Policy{"login": {Limit: 1, Period: time.Minute}}, logger)
+	h := newTestHandler(l, "login")
+
+	t.Run("limits by ip", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/login", nil))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "1", rec.Header().Get("RateLimit-Limit"))
+		assert.Equal(t, "0", rec.Header().Get("RateLimit-Remaining"))
+		assert.Equal(t, "60", rec.Header().Get("RateLimit-Reset"))
+		assert.Equal(t, "1;w=60", rec.Header().Get("RateLimit-Policy"))
+
+		rec = httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/login", nil))
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "60", rec.Header().Get("Retry-After"))
+		assert.Contains(t, rec.Body.String(), "Rate limit exceeded")
+
+		req := httptest.NewRequest(http.MethodPost, "/login", nil)
+		req.RemoteAddr = "198.51.100.7:4000"
+		rec = httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("limits by user", func(t *testing.T) {
+		for _, want := range []int{http.StatusNoContent, http.StatusTooManyRequests} {
+			req := httptest.NewRequest(http.MethodPost, "/login", nil)
+			req = req.WithContext(auth.WithPrincipal(req.Context(), &auth.Principal{UserID: 42}))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			assert.Equal(t, want, rec.Code)
+		}
+	})
+
+	t.Run("unknown policy and nil limiter", func(t *testing.T) {
+		for _, h := range []http.Handler{newTestHandler(l, "missing"), newTestHandler(nil, "login")} {
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/login", nil))
+			assert.Equal(t, http.StatusNoContent, rec.Code)
+			assert.Empty(t, rec.Header().Get("RateLimit-Limit"))
+		}
+	})
+
+	t.Run("store error allows request", func(t *testing.T) {
+		failing := New(failingStore{}, map[string]Policy{"login": {Limit: 1, Period: time.Minute}}, logger)
+		rec := httptest.NewRecorder()
+		newTestHandler(failing, "login").ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/login", nil))
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+}
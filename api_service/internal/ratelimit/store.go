@@ -0,0 +1,125 @@
+package ratelimit
+
+import (
+	"api_service/internal/grpc/grpc_server"
+	grpccache "api_service/internal/grpc_cache"
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Result - решение по одному запросу
+type Result struct {
+	Allowed   bool
+	Remaining int
+	// RetryAfter - через сколько появится токен для отклонённого запроса
+	RetryAfter time.Duration
+	// ResetAfter - через сколько бакет снова заполнится
+	ResetAfter time.Duration
+}
+
+// Store берёт один токен из бакета key
+type Store interface {
+	Take(ctx context.Context, key string, p Policy) (Result, error)
+}
+
+// sweepInterval - как часто MemoryStore удаляет заполнившиеся бакеты
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens float64
+	ts     time.Time
+	// full - когда бакет заполнится и станет неотличим от нового
+	full time.Time
+}
+
+// MemoryStore хранит бакеты в памяти процесса: у каждой реплики своя квота
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, p Policy) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	rate := p.Rate()
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(p.Limit), ts: now}
+		s.buckets[key] = b
+	}
+	if now.After(b.ts) {
+		b.tokens = math.Min(float64(p.Limit), b.tokens+now.Sub(b.ts).Seconds()*rate)
+		b.ts = now
+	}
+
+	var res Result
+	if b.tokens >= 1 {
+		b.tokens--
+		res.Allowed = true
+	} else {
+		res.RetryAfter = seconds((1 - b.tokens) / rate)
+	}
+	res.Remaining = int(b.tokens)
+	res.ResetAfter = seconds((float64(p.Limit) - b.tokens) / rate)
+	b.full = now.Add(res.ResetAfter)
+	return res, nil
+}
+
+// sweep удаляет полные бакеты, чтобы ключи по IP не копились бесконечно
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+	for key, b := range s.buckets {
+		if !now.Before(b.full) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(math.Ceil(s * float64(time.Second)))
+}
+
+// CacheStore хранит бакеты в Redis через cache_service: квота общая для всех реплик
+type CacheStore struct {
+	cache *grpccache.CacheClient
+}
+
+func NewCacheStore(cache *grpccache.CacheClient) *CacheStore {
+	return &CacheStore{cache: cache}
+}
+
+func (s *CacheStore) Take(ctx context.Context, key string, p Policy) (Result, error) {
+	resp, err := s.cache.RateLimit(ctx, &grpc_server.RateLimitRequest{
+		Key:        key,
+		Capacity:   int32(p.Limit),
+		RefillRate: p.Rate(),
+		Cost:       1,
+	})
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{
+		Allowed:    resp.Allowed,
+		Remaining:  int(resp.Remaining),
+		RetryAfter: time.Duration(resp.RetryAfterMs) * time.Millisecond,
+		ResetAfter: time.Duration(resp.ResetAfterMs) * time.Millisecond,
+	}, nil
+}
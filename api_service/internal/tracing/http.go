@@ -0,0 +1,32 @@
+// Package tracing - спаны HTTP запросов api_service. Провайдер спанов
+// настраивает общий пакет grpcmw/tracing.
+package tracing
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Handler создаёт спан на каждый HTTP запрос, продолжая трассу из traceparent
+func Handler(next http.Handler) http.Handler {
+	return otelhttp.NewHandler(next, "http.request")
+}
+
+// RouteMiddleware - chi middleware: после маршрутизации называет спан по шаблону
+// роута ("GET /tasks/{taskID}"), а не по URL, чтобы имена спанов не зависели от id
+func RouteMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r)
+		rctx := chi.RouteContext(r.Context())
+		if rctx == nil || rctx.RoutePattern() == "" {
+			return
+		}
+		span := trace.SpanFromContext(r.Context())
+		span.SetName(r.Method + " " + rctx.RoutePattern())
+		span.SetAttributes(semconv.HTTPRoute(rctx.RoutePattern()))
+	})
+}
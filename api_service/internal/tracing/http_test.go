@@ -0,0 +1,45 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	sharedtracing "platform/tracing"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestHTTPSpansToFile(t *testing.T) {
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+	file := filepath.Join(t.TempDir(), "spans.json")
+	shutdown, err := sharedtracing.Setup(context.Background(), "api_service", sharedtracing.Options{
+		Exporter:    "file",
+		File:        file,
+		SampleRatio: 1,
+	})
+	require.NoError(t, err)
+
+	r := chi.NewRouter()
+	r.Use(RouteMiddleware)
+	r.Get("/tasks/{taskID}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	req := httptest.NewRequest(http.MethodGet, "/tasks/42", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	Handler(r).ServeHTTP(httptest.NewRecorder(), req)
+
+	require.NoError(t, shutdown(context.Background()))
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	// Спан назван по шаблону роута и продолжает входящую трассу
+	assert.Contains(t, string(data), `"Name":"GET /tasks/{taskID}"`)
+	assert.Contains(t, string(data), "4bf92f3577b34da6a3ce929d0e0e4736")
+	assert.Contains(t, string(data), `"Value":"api_service"`)
+}
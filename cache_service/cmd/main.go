@@ -2,105 +2,190 @@ package main
 
 import (
 	"cache_service/internal/cache"
+	"cache_service/internal/config"
 	"cache_service/internal/grpc/grpc_server"
 	grpcclient "cache_service/internal/grpc_client"
-	"log"
+	"cache_service/internal/health"
+	"cache_service/internal/metrics"
+	"context"
+	"errors"
+	"grpcmw"
+	"log/slog"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"platform/logging"
+	"platform/tracing"
 	"syscall"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
-	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 )
 
-var (
-	cacheHits = prometheus.NewCounter(
-		prometheus.CounterOpts{
-			Name: "cache_hits_total",
-			Help: "Total cache hits",
-		},
-	)
-
-	cacheMisses = prometheus.NewCounter(
-		prometheus.CounterOpts{
-			Name: "cache_misses_total",
-			Help: "Total cache misses",
-		},
-	)
-
-	cacheLatency = prometheus.NewHistogram(
-		prometheus.HistogramOpts{
-			Name:    "cache_operation_duration_seconds",
-			Help:    "Cache operation duration",
-			Buckets: []float64{0.001, 0.005, 0.01, 0.05, 0.1},
-		},
-	)
-)
-
-func startMetricsAndHealthServer() {
+func newMetricsAndHealthServer(addr string, readiness *health.Readiness, checker *health.Checker) *http.Server {
 	mux := http.NewServeMux()
 
 	// Эндпоинт для метрик Prometheus
 	mux.Handle("/metrics", promhttp.Handler())
 
-	// Эндпоинт для health check
-	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("OK"))
-	})
+	// /health и /ready оставлены для blackbox проб, /livez и /readyz отдают JSON с проверками
+	mux.HandleFunc("/health", readiness.HealthHandler)
+	mux.HandleFunc("/ready", readiness.ReadyHandler)
+	mux.HandleFunc("/livez", checker.LivezHandler)
+	mux.HandleFunc("/readyz", checker.ReadyzHandler)
 
-	// Запуск сервера на порту 8051
-	if err := http.ListenAndServe(":8052", mux); err != nil {
-		log.Fatalf("Failed to start metrics/health server: %v", err)
+	return &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
 	}
 }
 
-func init() {
-	prometheus.MustRegister(cacheHits)
-	prometheus.MustRegister(cacheMisses)
-	prometheus.MustRegister(cacheLatency)
+// fatal пишет ошибку в лог и завершает процесс
+func fatal(msg string, err error) {
+	slog.Error(msg, slog.Any("error", err))
+	os.Exit(1)
 }
 
 func main() {
-	http.Handle("/metrics", promhttp.Handler())
-	go startMetricsAndHealthServer()
-
-	time.Sleep(3 * time.Second)
-	rdb, err := cache.NewCache(&redis.Options{
-		Addr:     "cache:6379",
-		Password: "admin",
-		DB:       0,
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		fatal("Invalid configuration", err)
+	}
+	if cfg.PrintOnly {
+		if err := cfg.Print(os.Stdout); err != nil {
+			fatal("Error printing configuration", err)
+		}
+		return
+	}
+
+	logger, err := logging.New(os.Stdout, cfg.Log.Level, cfg.Log.Format)
+	if err != nil {
+		fatal("Invalid log settings", err)
+	}
+	slog.SetDefault(logger)
+
+	shutdownTracing, err := tracing.Setup(context.Background(), "cache_service", tracing.Options{
+		Exporter:    cfg.Tracing.Exporter,
+		Endpoint:    cfg.Tracing.Endpoint,
+		File:        cfg.Tracing.File,
+		SampleRatio: cfg.Tracing.SampleRatio,
 	})
+	if err != nil {
+		fatal("Error setting up tracing", err)
+	}
 
+	// Redis может подняться позже сервиса: подключаемся в фоне, до этого /readyz
+	// и grpc.health.v1 сообщают, что сервис не готов
+	redisOptions, err := cache.Options(cfg.Redis)
 	if err != nil {
-		log.Fatal(err)
+		fatal("Invalid redis settings", err)
 	}
-	lis, err := net.Listen("tcp", ":50053")
+	rdb := cache.NewLazyCache(redisOptions)
+	if err := rdb.InstrumentTracing(); err != nil {
+		fatal("Error instrumenting redis client", err)
+	}
+	connectCtx, stopConnect := context.WithCancel(context.Background())
+	go func() {
+		if err := rdb.Connect(connectCtx, cache.Backoff{Base: cfg.Redis.BackoffBase, Max: cfg.Redis.BackoffMax}); err == nil {
+			logger.Info("Connected to Redis", slog.String("mode", cfg.Redis.Mode), slog.String("addr", cfg.Redis.Addr))
+		}
+	}()
+
+	readiness := health.NewReadiness()
+	checker := health.NewChecker(readiness, cfg.Health.Timeout, cfg.Health.CacheTTL,
+		health.Check{Name: "redis", Check: rdb.HealthCheck},
+	)
+	metricsServer := newMetricsAndHealthServer(cfg.Server.MetricsAddr, readiness, checker)
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fatal("Failed to start metrics/health server", err)
+		}
+	}()
+	lis, err := net.Listen("tcp", cfg.Server.GRPCAddr)
 	if err != nil {
-		log.Fatal(err)
+		fatal("Failed to listen", err)
 	}
 
-	s := grpc.NewServer()
-	grpc_server.RegisterCacheServiceServer(s, &grpcclient.CacheServiceServer{Cch: rdb})
+	prometheus.MustRegister(metrics.NewPoolCollector(rdb))
+
+	grpcMetrics := grpcmw.NewMetrics()
+	prometheus.MustRegister(grpcMetrics)
+	s := grpc.NewServer(
+		// Спан на каждый RPC, продолжает трассу из traceparent в metadata
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			append(grpcmw.UnaryServerInterceptors(grpcMetrics, logger), metrics.UnaryServerInterceptor())...,
+		),
+	)
+	grpc_server.RegisterCacheServiceServer(s, &grpcclient.CacheServiceServer{
+		Cch: rdb,
+		Login: cache.LoginPolicy{
+			Window:          cfg.Login.Window,
+			MaxUserFailures: cfg.Login.MaxUserFailures,
+			MaxIPFailures:   cfg.Login.MaxIPFailures,
+			LockoutBase:     cfg.Login.LockoutBase,
+			LockoutMax:      cfg.Login.LockoutMax,
+		},
+		Sessions: cache.SessionPolicy{
+			TTL:         cfg.Session.TTL,
+			IdleTimeout: cfg.Session.IdleTimeout,
+			MaxLifetime: cfg.Session.MaxLifetime,
+		},
+	})
+	// grpc.health.v1 следует за доступностью Redis
+	serving := health.NewServing(rdb.HealthCheck, cfg.Health.Interval, cfg.Health.Timeout,
+		grpc_server.CacheService_ServiceDesc.ServiceName)
+	serving.Register(s)
+	servingCtx, stopServing := context.WithCancel(context.Background())
+	go serving.Run(servingCtx)
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 
 	go func() {
-		log.Printf("Server started at %v\n", lis.Addr())
+		logger.Info("Server started", slog.String("addr", lis.Addr().String()))
 		if err := s.Serve(lis); err != nil {
-			log.Fatal("Failed to serve: %v", err)
+			fatal("Failed to serve", err)
 		}
 	}()
 
 	<-quit
-	log.Println("Shutting down server...")
-	s.GracefulStop()
-	log.Println("Server stopped")
+	logger.Info("Shutting down server")
+	readiness.SetDraining()
+	// Клиенты видят NOT_SERVING и уходят на другие узлы, пока идёт GracefulStop
+	stopServing()
+	stopConnect()
+	serving.Shutdown()
+
+	// GracefulStop ждёт завершения всех RPC, поэтому ограничен по времени
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(cfg.Server.ShutdownTimeout):
+		logger.Warn("Graceful stop timed out, closing connections")
+		s.Stop()
+	}
+
+	if err := rdb.Close(); err != nil {
+		logger.Error("Error closing redis", slog.Any("error", err))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+	if err := metricsServer.Shutdown(ctx); err != nil {
+		logger.Error("Error shutting down metrics/health server", slog.Any("error", err))
+	}
+	if err := shutdownTracing(ctx); err != nil {
+		logger.Error("Error flushing traces", slog.Any("error", err))
+	}
+	logger.Info("Server stopped")
 
 }
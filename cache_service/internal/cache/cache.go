@@ -6,15 +6,24 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 )
 
+// Cache работает с одиночным Redis, Sentinel и Cluster через redis.UniversalClient.
+// Несколько ключей в одной команде или скрипте допустимы только в одном слоте
+// кластера (общий hash tag), поэтому индексы пишутся отдельными командами.
 type Cache struct {
-	rdb *redis.Client
+	rdb redis.UniversalClient
 }
 
-func NewCache(opt *redis.Options) (*Cache, error) {
-	db := redis.NewClient(opt)
+// userKey - ключ множества всех jwt пользователя, нужен для выхода со всех устройств
+func userKey(userID string) string {
+	return "user:" + userID + ":tokens"
+}
+
+func NewCache(opt *redis.UniversalOptions) (*Cache, error) {
+	db := redis.NewUniversalClient(opt)
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
 
@@ -32,6 +41,16 @@ func (c *Cache) Close() error {
 	return c.rdb.Close()
 }
 
+// InstrumentTracing добавляет спан на каждую команду Redis
+func (c *Cache) InstrumentTracing() error {
+	return redisotel.InstrumentTracing(c.rdb)
+}
+
+// PoolStats - статистика пула соединений для метрик
+func (c *Cache) PoolStats() *redis.PoolStats {
+	return c.rdb.PoolStats()
+}
+
 func (c *Cache) HealthCheck(ctx context.Context) error {
 	if c == nil || c.rdb == nil {
 		return fmt.Errorf("Cache is not init")
@@ -40,42 +59,63 @@ func (c *Cache) HealthCheck(ctx context.Context) error {
 	return err
 }
 
-func (c *Cache) SetData(ctx context.Context, jwt, login string, user_id int32) error {
-	if jwt == "" {
-		return fmt.Errorf("empty jwt")
-	}
-	hashKey := jwt
-	fields := map[string]interface{}{
-		"login":   login,
-		"user_id": user_id,
+// Delete удаляет сессию; если она выпущена по refresh токену, отзывается всё семейство.
+// Была ли сессия, решает ответ DEL, а не отдельный EXISTS: из параллельных удалений
+// одного токена успешно только одно, остальные получают ErrSessionNotFound.
+func (c *Cache) Delete(ctx context.Context, hash string) error {
+	val, err := c.rdb.HMGet(ctx, hash, "user_id", "family").Result()
+	if err != nil && err != redis.Nil {
+		return err
 	}
+	userID, _ := val[0].(string)
+	family, _ := val[1].(string)
 
-	return c.rdb.HSet(ctx, hashKey, fields).Err()
+	pipe := c.rdb.TxPipeline()
+	del := pipe.Del(ctx, hash)
+	if userID != "" {
+		pipe.SRem(ctx, userKey(userID), hash)
+	}
+	if _, err = pipe.Exec(ctx); err != nil {
+		return err
+	}
+	if del.Val() == 0 {
+		return ErrSessionNotFound
+	}
+	_, err = c.RevokeFamily(ctx, family)
+	return err
 }
 
-func (c *Cache) SetDeadTime(ctx context.Context, hash string, dl time.Duration) error {
-	return c.rdb.Expire(ctx, hash, dl).Err()
-}
+// DeleteUser удаляет все jwt пользователя и возвращает количество удалённых ключей
+func (c *Cache) DeleteUser(ctx context.Context, user_id int32) (int, error) {
+	key := userKey(strconv.Itoa(int(user_id)))
+	hashes, err := c.rdb.SMembers(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
 
-func (c *Cache) Delete(ctx context.Context, hash string) error {
-	return c.rdb.Del(ctx, hash).Err()
+	pipe := c.rdb.TxPipeline()
+	deleted := delEach(ctx, pipe, hashes)
+	pipe.Del(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return countDeleted(deleted), nil
 }
 
-func (c *Cache) Exists(ctx context.Context, hash string) bool {
-	_, err := c.rdb.Get(ctx, hash).Result()
-	return err != redis.Nil
+// delEach удаляет ключи по одному: в кластере DEL с ключами из разных слотов
+// отвечает CROSSSLOT
+func delEach(ctx context.Context, pipe redis.Pipeliner, keys []string) []*redis.IntCmd {
+	cmds := make([]*redis.IntCmd, len(keys))
+	for i, key := range keys {
+		cmds[i] = pipe.Del(ctx, key)
+	}
+	return cmds
 }
 
-func (c *Cache) GetData(ctx context.Context, hash string) (int, string, error) {
-	if !c.Exists(ctx, hash) {
-		return 0, "", fmt.Errorf("non-existent key")
-	}
-	val, err := c.rdb.HGetAll(ctx, hash).Result()
-	if err == redis.Nil {
-		return 0, "", err
-	} else if err != nil {
-		return 0, "", fmt.Errorf("Ошибка чтения хэша")
+func countDeleted(cmds []*redis.IntCmd) int {
+	n := 0
+	for _, cmd := range cmds {
+		n += int(cmd.Val())
 	}
-	user_id, _ := strconv.Atoi(val["user_id"])
-	return user_id, val["login"], nil
+	return n
 }
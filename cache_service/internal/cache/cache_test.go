@@ -2,6 +2,7 @@ package cache
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -15,8 +16,8 @@ func setupTestRedis(t *testing.T) (*Cache, func()) {
 	mr, err := miniredis.Run()
 	require.NoError(t, err)
 
-	options := &redis.Options{
-		Addr: mr.Addr(),
+	options := &redis.UniversalOptions{
+		Addrs: []string{mr.Addr()},
 	}
 
 	cache, err := NewCache(options)
@@ -28,14 +29,29 @@ func setupTestRedis(t *testing.T) (*Cache, func()) {
 	}
 }
 
+// saveSession создаёт сессию на час так же, как её пишет cache_service
+func saveSession(t *testing.T, c *Cache, token string, userID int32) {
+	t.Helper()
+	_, _, err := c.SaveSession(context.Background(), token, Session{UserID: userID, Login: "user"},
+		time.Hour, SessionPolicy{TTL: time.Hour})
+	require.NoError(t, err)
+}
+
+func exists(t *testing.T, c *Cache, key string) bool {
+	t.Helper()
+	n, err := c.rdb.Exists(context.Background(), key).Result()
+	require.NoError(t, err)
+	return n > 0
+}
+
 func TestNewCache(t *testing.T) {
 	t.Run("successful connection", func(t *testing.T) {
 		mr, err := miniredis.Run()
 		require.NoError(t, err)
 		defer mr.Close()
 
-		options := &redis.Options{
-			Addr: mr.Addr(),
+		options := &redis.UniversalOptions{
+			Addrs: []string{mr.Addr()},
 		}
 
 		cache, err := NewCache(options)
@@ -45,8 +61,8 @@ func TestNewCache(t *testing.T) {
 	})
 
 	t.Run("failed connection", func(t *testing.T) {
-		options := &redis.Options{
-			Addr: "invalid-address:6379",
+		options := &redis.UniversalOptions{
+			Addrs: []string{"invalid-address:6379"},
 		}
 
 		cache, err := NewCache(options)
@@ -80,144 +96,105 @@ func TestHealthCheck(t *testing.T) {
 	})
 }
 
-func TestSetData(t *testing.T) {
+func TestDelete(t *testing.T) {
 	cache, cleanup := setupTestRedis(t)
 	defer cleanup()
 
 	ctx := context.Background()
 
-	t.Run("successful set data", func(t *testing.T) {
-		jwt := "test-jwt-token"
-		login := "testuser"
-		userID := int32(123)
+	t.Run("successful delete", func(t *testing.T) {
+		jwt := "test-jwt-delete"
+		saveSession(t, cache, jwt, 123)
+		assert.True(t, exists(t, cache, jwt))
 
-		err := cache.SetData(ctx, jwt, login, userID)
-		assert.NoError(t, err)
+		assert.NoError(t, cache.Delete(ctx, jwt))
+		assert.False(t, exists(t, cache, jwt))
+	})
 
-		// Проверяем, что данные записались
-		val, err := cache.rdb.HGetAll(ctx, jwt).Result()
-		assert.NoError(t, err)
-		assert.Equal(t, login, val["login"])
-		assert.Equal(t, "123", val["user_id"])
+	t.Run("delete non-existent key", func(t *testing.T) {
+		err := cache.Delete(ctx, "non-existent-key")
+		assert.ErrorIs(t, err, ErrSessionNotFound)
 	})
 
-	t.Run("empty jwt", func(t *testing.T) {
-		err := cache.SetData(ctx, "", "user", 123)
-		assert.Error(t, err)
+	t.Run("concurrent deletes", func(t *testing.T) {
+		jwt := "test-jwt-race"
+		saveSession(t, cache, jwt, 123)
+
+		// Сессию удаляет ровно один из параллельных вызовов
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		succeeded := 0
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				err := cache.Delete(ctx, jwt)
+				if err == nil {
+					mu.Lock()
+					succeeded++
+					mu.Unlock()
+					return
+				}
+				assert.ErrorIs(t, err, ErrSessionNotFound)
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 1, succeeded)
 	})
 }
 
-func TestSetDeadTime(t *testing.T) {
+func TestClose(t *testing.T) {
 	cache, cleanup := setupTestRedis(t)
 	defer cleanup()
 
-	ctx := context.Background()
-
-	t.Run("successful set TTL", func(t *testing.T) {
-		jwt := "test-jwt-ttl"
-		err := cache.SetData(ctx, jwt, "user", 123)
-		require.NoError(t, err)
-
-		ttl := time.Minute * 30
-		err = cache.SetDeadTime(ctx, jwt, ttl)
-		assert.NoError(t, err)
-
-		// Проверяем TTL
-		actualTTL := cache.rdb.TTL(ctx, jwt).Val()
-		assert.True(t, actualTTL > ttl-time.Second*5 && actualTTL <= ttl)
-	})
+	err := cache.Close()
+	assert.NoError(t, err)
 
-	t.Run("non-existent key", func(t *testing.T) {
-		err := cache.SetDeadTime(ctx, "non-existent-key", time.Minute)
-		assert.NoError(t, err) // Redis не возвращает ошибку для несуществующих ключей
-	})
+	// Проверяем, что соединение действительно закрыто
+	err = cache.HealthCheck(context.Background())
+	assert.Error(t, err)
 }
 
-func TestDelete(t *testing.T) {
+func TestDeleteUser(t *testing.T) {
 	cache, cleanup := setupTestRedis(t)
 	defer cleanup()
 
 	ctx := context.Background()
 
-	t.Run("successful delete", func(t *testing.T) {
-		jwt := "test-jwt-delete"
-		err := cache.SetData(ctx, jwt, "user", 123)
-		require.NoError(t, err)
+	t.Run("delete all user tokens", func(t *testing.T) {
+		saveSession(t, cache, "jwt-phone", 77)
+		saveSession(t, cache, "jwt-laptop", 77)
+		saveSession(t, cache, "jwt-other", 78)
 
-		// Проверяем, что ключ существует
-		exists := cache.Exists(ctx, jwt)
-		assert.True(t, exists)
-
-		// Удаляем
-		err = cache.Delete(ctx, jwt)
+		deleted, err := cache.DeleteUser(ctx, 77)
 		assert.NoError(t, err)
+		assert.Equal(t, 2, deleted)
 
-		// Проверяем, что ключ удален
-		exists = cache.Exists(ctx, jwt)
-		assert.False(t, exists)
+		assert.False(t, exists(t, cache, "jwt-phone"))
+		assert.False(t, exists(t, cache, "jwt-laptop"))
+		assert.True(t, exists(t, cache, "jwt-other"))
 	})
 
-	t.Run("delete non-existent key", func(t *testing.T) {
-		err := cache.Delete(ctx, "non-existent-key")
+	t.Run("single delete removes token from index", func(t *testing.T) {
+		saveSession(t, cache, "jwt-a", 90)
+		saveSession(t, cache, "jwt-b", 90)
+		require.NoError(t, cache.Delete(ctx, "jwt-a"))
+
+		members, err := cache.rdb.SMembers(ctx, userKey("90")).Result()
 		assert.NoError(t, err)
+		assert.Equal(t, []string{"jwt-b"}, members)
 	})
-}
-
-func TestExists(t *testing.T) {
-	cache, cleanup := setupTestRedis(t)
-	defer cleanup()
-
-	ctx := context.Background()
-
-	t.Run("key exists", func(t *testing.T) {
-		jwt := "test-jwt-exists"
-		err := cache.SetData(ctx, jwt, "user", 123)
-		require.NoError(t, err)
 
-		exists := cache.Exists(ctx, jwt)
-		assert.True(t, exists)
-	})
+	t.Run("index expires with sessions", func(t *testing.T) {
+		saveSession(t, cache, "jwt-ttl", 91)
 
-	t.Run("key does not exist", func(t *testing.T) {
-		exists := cache.Exists(ctx, "non-existent-key")
-		assert.False(t, exists)
+		ttl := cache.rdb.TTL(ctx, userKey("91")).Val()
+		assert.True(t, ttl > time.Hour-time.Second*5 && ttl <= time.Hour)
 	})
-}
-
-func TestGetData(t *testing.T) {
-	cache, cleanup := setupTestRedis(t)
-	defer cleanup()
-
-	ctx := context.Background()
-
-	t.Run("successful get data", func(t *testing.T) {
-		jwt := "test-jwt-get"
-		login := "testuser"
-		userID := 456
 
-		err := cache.SetData(ctx, jwt, login, int32(userID))
-		require.NoError(t, err)
-
-		gotUserID, gotLogin, err := cache.GetData(ctx, jwt)
+	t.Run("user without tokens", func(t *testing.T) {
+		deleted, err := cache.DeleteUser(ctx, 100)
 		assert.NoError(t, err)
-		assert.Equal(t, userID, gotUserID)
-		assert.Equal(t, login, gotLogin)
-	})
-
-	t.Run("non-existent key", func(t *testing.T) {
-		_, _, err := cache.GetData(ctx, "non-existent-key")
-		assert.Error(t, err)
+		assert.Equal(t, 0, deleted)
 	})
 }
-
-func TestClose(t *testing.T) {
-	cache, cleanup := setupTestRedis(t)
-	defer cleanup()
-
-	err := cache.Close()
-	assert.NoError(t, err)
-
-	// Проверяем, что соединение действительно закрыто
-	err = cache.HealthCheck(context.Background())
-	assert.Error(t, err)
-}
@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"context"
+	"log/slog"
+	"math/rand"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Backoff - экспоненциальная задержка с jitter между попытками подключения
+type Backoff struct {
+	Base time.Duration
+	Max  time.Duration
+}
+
+// Delay возвращает задержку перед попыткой attempt (с нуля): половина
+// base*2^attempt (не больше Max) плюс случайная добавка до второй половины
+func (b Backoff) Delay(attempt int) time.Duration {
+	d := b.Base
+	for i := 0; i < attempt && d < b.Max; i++ {
+		d *= 2
+	}
+	if d > b.Max {
+		d = b.Max
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// NewLazyCache создаёт клиент без проверки соединения: go-redis подключается при
+// первой команде, поэтому недоступный на старте Redis не роняет сервис
+func NewLazyCache(opt *redis.UniversalOptions) *Cache {
+	return &Cache{rdb: redis.NewUniversalClient(opt)}
+}
+
+// Connect пингует Redis с backoff, пока он не ответит или не отменят ctx
+func (c *Cache) Connect(ctx context.Context, b Backoff) error {
+	for attempt := 0; ; attempt++ {
+		err := c.HealthCheck(ctx)
+		if err == nil {
+			return nil
+		}
+		delay := b.Delay(attempt)
+		slog.WarnContext(ctx, "Redis is not available, retrying",
+			slog.Int("attempt", attempt+1), slog.Any("error", err), slog.Duration("retry_in", delay))
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	b := Backoff{Base: 100 * time.Millisecond, Max: time.Second}
+	for attempt, want := range []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	} {
+		for i := 0; i < 20; i++ {
+			d := b.Delay(attempt)
+			assert.GreaterOrEqual(t, d, want/2)
+			assert.LessOrEqual(t, d, want)
+		}
+	}
+}
+
+func TestLazyCacheConnect(t *testing.T) {
+	mr := miniredis.NewMiniRedis()
+	// Порт занимаем заранее, Redis запускается позже клиента
+	require.NoError(t, mr.Start())
+	addr := mr.Addr()
+	mr.Close()
+
+	cache := NewLazyCache(&redis.UniversalOptions{Addrs: []string{addr}, MaxRetries: -1})
+	defer cache.Close()
+	assert.Error(t, cache.HealthCheck(context.Background()))
+
+	done := make(chan error, 1)
+	go func() {
+		done <- cache.Connect(context.Background(), Backoff{Base: 10 * time.Millisecond, Max: 50 * time.Millisecond})
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	require.NoError(t, mr.StartAddr(addr))
+	defer mr.Close()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("Connect did not return after Redis started")
+	}
+	assert.NoError(t, cache.HealthCheck(context.Background()))
+}
+
+func TestConnectCanceled(t *testing.T) {
+	cache := NewLazyCache(&redis.UniversalOptions{Addrs: []string{"127.0.0.1:1"}, MaxRetries: -1})
+	defer cache.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, cache.Connect(ctx, Backoff{Base: 10 * time.Millisecond, Max: 20 * time.Millisecond}), context.DeadlineExceeded)
+}
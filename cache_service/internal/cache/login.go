@@ -0,0 +1,199 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// lockoutMemory - сколько помнится число блокировок для удвоения следующей
+const lockoutMemory = 24 * time.Hour
+
+// LoginPolicy - пороги блокировки входа
+type LoginPolicy struct {
+	// Window - скользящее окно подсчёта неудачных попыток
+	Window          time.Duration
+	MaxUserFailures int
+	MaxIPFailures   int
+	// LockoutBase удваивается с каждой следующей блокировкой до LockoutMax
+	LockoutBase time.Duration
+	LockoutMax  time.Duration
+}
+
+// LoginStatus - состояние блокировки логина и адреса
+type LoginStatus struct {
+	Locked bool
+	// RetryAfter - когда снимется самая долгая из блокировок
+	RetryAfter   time.Duration
+	UserFailures int
+	IPFailures   int
+}
+
+// loginKeys - ключи одного субъекта (пользователя или IP). Hash tag держит их
+// в одном слоте Redis Cluster, чтобы скрипт мог работать со всеми тремя.
+type loginKeys struct {
+	failures string
+	lock     string
+	lockouts string
+}
+
+func newLoginKeys(kind, subject string) loginKeys {
+	prefix := "login:{" + kind + ":" + subject + "}:"
+	return loginKeys{
+		failures: prefix + "failures",
+		lock:     prefix + "lock",
+		lockouts: prefix + "lockouts",
+	}
+}
+
+func (k loginKeys) all() []string {
+	return []string{k.failures, k.lock, k.lockouts}
+}
+
+// loginFailScript добавляет неудачную попытку в окно и при превышении порога
+// блокирует субъекта. Возвращает число попыток в окне и ttl блокировки в мс (<0 - нет).
+// KEYS: failures, lock, lockouts; ARGV: now (мс), window, threshold, base, max, memory (мс), member
+var loginFailScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local threshold = tonumber(ARGV[3])
+local base = tonumber(ARGV[4])
+local max = tonumber(ARGV[5])
+
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+redis.call('ZADD', KEYS[1], now, ARGV[7])
+redis.call('PEXPIRE', KEYS[1], window)
+local failures = redis.call('ZCARD', KEYS[1])
+if failures < threshold then
+	return {failures, redis.call('PTTL', KEYS[2])}
+end
+
+-- Каждая следующая блокировка вдвое длиннее предыдущей
+local lockouts = redis.call('INCR', KEYS[3])
+redis.call('PEXPIRE', KEYS[3], ARGV[6])
+local duration = base
+for i = 2, lockouts do
+	duration = duration * 2
+	if duration >= max then
+		break
+	end
+end
+if duration > max then
+	duration = max
+end
+redis.call('SET', KEYS[2], lockouts, 'PX', duration)
+-- После блокировки окно начинается заново
+redis.call('DEL', KEYS[1])
+return {failures, duration}
+`)
+
+// LoginFailed учитывает неудачный вход для логина и адреса
+func (c *Cache) LoginFailed(ctx context.Context, username, ip string, p LoginPolicy) (LoginStatus, error) {
+	return c.loginFailed(ctx, username, ip, p, time.Now())
+}
+
+func (c *Cache) loginFailed(ctx context.Context, username, ip string, p LoginPolicy, now time.Time) (LoginStatus, error) {
+	var st LoginStatus
+	subjects := []struct {
+		kind, subject string
+		threshold     int
+		failures      *int
+	}{
+		{"user", username, p.MaxUserFailures, &st.UserFailures},
+		{"ip", ip, p.MaxIPFailures, &st.IPFailures},
+	}
+	// Уникальный member, чтобы одновременные попытки не схлопнулись в одну
+	member := strconv.FormatInt(now.UnixNano(), 10) + ":" + ip
+	for _, s := range subjects {
+		if s.subject == "" {
+			continue
+		}
+		res, err := loginFailScript.Run(ctx, c.rdb, newLoginKeys(s.kind, s.subject).all(),
+			now.UnixMilli(), p.Window.Milliseconds(), s.threshold,
+			p.LockoutBase.Milliseconds(), p.LockoutMax.Milliseconds(),
+			lockoutMemory.Milliseconds(), member).Int64Slice()
+		if err != nil {
+			return LoginStatus{}, err
+		}
+		if len(res) != 2 {
+			return LoginStatus{}, fmt.Errorf("unexpected login reply %v", res)
+		}
+		*s.failures = int(res[0])
+		st.lock(time.Duration(res[1]) * time.Millisecond)
+	}
+	return st, nil
+}
+
+// lock учитывает блокировку с оставшимся временем d (отрицательное - блокировки нет)
+func (st *LoginStatus) lock(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	st.Locked = true
+	st.RetryAfter = max(st.RetryAfter, d)
+}
+
+// LoginStatus проверяет, заблокированы ли логин или адрес
+func (c *Cache) LoginStatus(ctx context.Context, username, ip string, window time.Duration) (LoginStatus, error) {
+	user, addr := newLoginKeys("user", username), newLoginKeys("ip", ip)
+	since := strconv.FormatInt(time.Now().Add(-window).UnixMilli(), 10)
+
+	var userLock, ipLock *redis.DurationCmd
+	var userFailures, ipFailures *redis.IntCmd
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		userLock = pipe.PTTL(ctx, user.lock)
+		ipLock = pipe.PTTL(ctx, addr.lock)
+		userFailures = pipe.ZCount(ctx, user.failures, since, "+inf")
+		ipFailures = pipe.ZCount(ctx, addr.failures, since, "+inf")
+		return nil
+	})
+	if err != nil {
+		return LoginStatus{}, err
+	}
+
+	st := LoginStatus{
+		UserFailures: int(userFailures.Val()),
+		IPFailures:   int(ipFailures.Val()),
+	}
+	if username != "" {
+		st.lock(userLock.Val())
+	}
+	if ip != "" {
+		st.lock(ipLock.Val())
+	}
+	return st, nil
+}
+
+// LoginSucceeded сбрасывает неудачные попытки и историю блокировок логина.
+// Счётчик адреса не сбрасывается: при подборе по базе утечек часть паролей подходит.
+func (c *Cache) LoginSucceeded(ctx context.Context, username string) error {
+	if username == "" {
+		return nil
+	}
+	k := newLoginKeys("user", username)
+	return c.rdb.Del(ctx, k.failures, k.lockouts).Err()
+}
+
+// ClearLoginLock снимает блокировку и сбрасывает счётчики логина и/или адреса.
+// Возвращает число снятых блокировок.
+func (c *Cache) ClearLoginLock(ctx context.Context, username, ip string) (int, error) {
+	cleared := 0
+	for _, s := range []struct{ kind, subject string }{{"user", username}, {"ip", ip}} {
+		if s.subject == "" {
+			continue
+		}
+		k := newLoginKeys(s.kind, s.subject)
+		locks, err := c.rdb.Del(ctx, k.lock).Result()
+		if err != nil {
+			return cleared, err
+		}
+		if err := c.rdb.Del(ctx, k.failures, k.lockouts).Err(); err != nil {
+			return cleared, err
+		}
+		cleared += int(locks)
+	}
+	return cleared, nil
+}
@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginLockout(t *testing.T) {
+	mr := miniredis.RunT(t)
+	cache, err := NewCache(&redis.UniversalOptions{Addrs: []string{mr.Addr()}})
+	require.NoError(t, err)
+	defer cache.Close()
+
+	ctx := context.Background()
+	policy := LoginPolicy{
+		Window:          15 * time.Minute,
+		MaxUserFailures: 3,
+		MaxIPFailures:   5,
+		LockoutBase:     time.Minute,
+		LockoutMax:      3 * time.Minute,
+	}
+	now := time.Now()
+	fail := func(username, ip string) LoginStatus {
+		t.Helper()
+		now = now.Add(time.Second)
+		st, err := cache.loginFailed(ctx, username, ip, policy, now)
+		require.NoError(t, err)
+		return st
+	}
+
+	t.Run("progressive user lockout", func(t *testing.T) {
+		assert.False(t, fail("alice", "10.0.0.1").Locked)
+		st := fail("alice", "10.0.0.2")
+		assert.False(t, st.Locked)
+		assert.Equal(t, 2, st.UserFailures)
+
+		st = fail("alice", "10.0.0.3")
+		assert.True(t, st.Locked)
+		assert.Equal(t, time.Minute, st.RetryAfter)
+
+		st, err := cache.LoginStatus(ctx, "alice", "10.0.0.9", policy.Window)
+		require.NoError(t, err)
+		assert.True(t, st.Locked)
+		assert.Equal(t, 0, st.UserFailures, "window restarts after lockout")
+
+		// Вторая блокировка вдвое длиннее, третья упирается в LockoutMax
+		mr.FastForward(time.Minute)
+		for _, want := range []time.Duration{2 * time.Minute, 3 * time.Minute} {
+			fail("alice", "10.0.0.1")
+			fail("alice", "10.0.0.1")
+			st = fail("alice", "10.0.0.1")
+			assert.Equal(t, want, st.RetryAfter)
+			mr.FastForward(want)
+		}
+	})
+
+	t.Run("ip lockout across usernames", func(t *testing.T) {
+		for _, name := range []string{"u1", "u2", "u3", "u4"} {
+			assert.False(t, fail(name, "192.0.2.1").Locked)
+		}
+		assert.True(t, fail("u5", "192.0.2.1").Locked)
+
+		st, err := cache.LoginStatus(ctx, "someone-else", "192.0.2.1", policy.Window)
+		require.NoError(t, err)
+		assert.True(t, st.Locked)
+
+		cleared, err := cache.ClearLoginLock(ctx, "", "192.0.2.1")
+		require.NoError(t, err)
+		assert.Equal(t, 1, cleared)
+		st, err = cache.LoginStatus(ctx, "someone-else", "192.0.2.1", policy.Window)
+		require.NoError(t, err)
+		assert.False(t, st.Locked)
+	})
+
+	t.Run("success resets user failures", func(t *testing.T) {
+		fail("bob", "198.51.100.1")
+		fail("bob", "198.51.100.1")
+		require.NoError(t, cache.LoginSucceeded(ctx, "bob"))
+
+		st := fail("bob", "198.51.100.1")
+		assert.False(t, st.Locked)
+		assert.Equal(t, 1, st.UserFailures)
+		assert.Equal(t, 3, st.IPFailures, "ip failures are kept")
+	})
+
+	t.Run("window expires", func(t *testing.T) {
+		fail("carol", "")
+		fail("carol", "")
+		now = now.Add(policy.Window)
+		st := fail("carol", "")
+		assert.False(t, st.Locked)
+		assert.Equal(t, 1, st.UserFailures)
+	})
+}
@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"cache_service/internal/config"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Options собирает настройки клиента для standalone, sentinel или cluster режима
+func Options(cfg config.Redis) (*redis.UniversalOptions, error) {
+	opt := &redis.UniversalOptions{
+		Addrs:            cfg.Addrs(),
+		Username:         cfg.Username,
+		Password:         cfg.Password,
+		SentinelUsername: cfg.SentinelUsername,
+		SentinelPassword: cfg.SentinelPassword,
+		DB:               cfg.DB,
+	}
+	switch cfg.Mode {
+	case "sentinel":
+		opt.MasterName = cfg.MasterName
+	case "cluster":
+		// Кластер с одним seed адресом иначе был бы принят за одиночный Redis
+		opt.IsClusterMode = true
+	}
+
+	if cfg.TLS.Enabled {
+		tlsConfig, err := tlsConfig(cfg.TLS)
+		if err != nil {
+			return nil, err
+		}
+		opt.TLSConfig = tlsConfig
+	}
+	return opt, nil
+}
+
+func tlsConfig(cfg config.RedisTLS) (*tls.Config, error) {
+	tc := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.ServerName,
+	}
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading redis CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("Error reading redis CA file: no certificates in %s", cfg.CAFile)
+		}
+		tc.RootCAs = pool
+	}
+	if cfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading redis client certificate: %w", err)
+		}
+		tc.Certificates = []tls.Certificate{cert}
+	}
+	return tc, nil
+}
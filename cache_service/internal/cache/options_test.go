@@ -0,0 +1,74 @@
+package cache
+
+import (
+	"cache_service/internal/config"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptions(t *testing.T) {
+	opt, err := Options(config.Redis{Mode: "standalone", Addr: "cache:6379", Username: "app", Password: "secret", DB: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cache:6379"}, opt.Addrs)
+	assert.Equal(t, "app", opt.Username)
+	assert.Equal(t, 2, opt.DB)
+	assert.False(t, opt.IsClusterMode)
+	assert.Nil(t, opt.TLSConfig)
+
+	opt, err = Options(config.Redis{Mode: "sentinel", Addr: "s1:26379,s2:26379", MasterName: "mymaster", SentinelPassword: "s"})
+	require.NoError(t, err)
+	assert.Equal(t, "mymaster", opt.MasterName)
+	assert.Equal(t, []string{"s1:26379", "s2:26379"}, opt.Addrs)
+	assert.Equal(t, "s", opt.SentinelPassword)
+
+	opt, err = Options(config.Redis{Mode: "cluster", Addr: "seed:6379", TLS: config.RedisTLS{Enabled: true, ServerName: "redis.internal"}})
+	require.NoError(t, err)
+	assert.True(t, opt.IsClusterMode)
+	require.NotNil(t, opt.TLSConfig)
+	assert.Equal(t, "redis.internal", opt.TLSConfig.ServerName)
+
+	badCA := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(badCA, []byte("not a certificate"), 0o600))
+	_, err = Options(config.Redis{Mode: "standalone", Addr: "cache:6379", TLS: config.RedisTLS{Enabled: true, CAFile: badCA}})
+	assert.ErrorContains(t, err, "no certificates")
+
+	_, err = Options(config.Redis{Mode: "standalone", Addr: "cache:6379", TLS: config.RedisTLS{Enabled: true, CertFile: "missing.pem", KeyFile: "missing.key"}})
+	assert.ErrorContains(t, err, "client certificate")
+}
+
+// miniredis отвечает на CLUSTER SLOTS как кластер из одного узла, этого хватает,
+// чтобы прогнать сессии и refresh токены через ClusterClient
+func TestClusterMode(t *testing.T) {
+	mr := miniredis.RunT(t)
+	cache, err := NewCache(&redis.UniversalOptions{Addrs: []string{mr.Addr()}, IsClusterMode: true})
+	require.NoError(t, err)
+	defer cache.Close()
+	_, ok := cache.rdb.(*redis.ClusterClient)
+	require.True(t, ok)
+	ctx := context.Background()
+
+	s, _, err := cache.SaveSession(ctx, "jwt-1", Session{UserID: 7, Login: "alice"}, time.Hour, testSessions)
+	require.NoError(t, err)
+	require.NoError(t, cache.SetRefreshToken(ctx, "rt-1", RefreshToken{UserID: 7, Login: "alice", Family: "fam"}, time.Hour))
+	require.NoError(t, cache.AddToFamily(ctx, "fam", "jwt-1", time.Hour))
+	_, err = cache.RotateRefreshToken(ctx, "rt-1", "rt-2", time.Hour)
+	require.NoError(t, err)
+
+	sessions, err := cache.ListSessions(ctx, 7)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, s.ID, sessions[0].ID)
+
+	deleted, err := cache.DeleteUser(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, 3, deleted)
+	assert.False(t, exists(t, cache, "jwt-1"))
+}
@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Limit - параметры token bucket: Capacity токенов, пополнение Rate токенов в секунду
+type Limit struct {
+	Capacity int
+	Rate     float64
+}
+
+// Decision - результат попытки взять токены из бакета
+type Decision struct {
+	Allowed   bool
+	Remaining int
+	// RetryAfter - через сколько хватит токенов на отклонённый запрос
+	RetryAfter time.Duration
+	// ResetAfter - через сколько бакет снова заполнится
+	ResetAfter time.Duration
+}
+
+func rateLimitKey(key string) string {
+	return "ratelimit:" + key
+}
+
+// takeScript пополняет бакет за прошедшее время и списывает cost токенов.
+// Чтение и запись выполняются одной командой, поэтому реплики api_service
+// не могут вдвоём потратить последний токен.
+// KEYS[1] - бакет; ARGV: capacity, rate (токенов в секунду), cost, now (мс)
+var takeScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local cost = tonumber(ARGV[3])
+local now = tonumber(ARGV[4])
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil or ts == nil then
+	tokens = capacity
+	ts = now
+end
+if now > ts then
+	tokens = math.min(capacity, tokens + (now - ts) * rate / 1000)
+	ts = now
+end
+
+local allowed = 0
+local retry = 0
+if tokens >= cost then
+	tokens = tokens - cost
+	allowed = 1
+else
+	retry = math.ceil((cost - tokens) * 1000 / rate)
+end
+
+local reset = math.ceil((capacity - tokens) * 1000 / rate)
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(ts))
+-- Полный бакет не отличается от отсутствующего, поэтому ключ живёт до заполнения
+redis.call('PEXPIRE', KEYS[1], math.max(reset, 1))
+return {allowed, math.floor(tokens), retry, reset}
+`)
+
+// TakeToken списывает cost токенов из бакета key
+func (c *Cache) TakeToken(ctx context.Context, key string, limit Limit, cost int) (Decision, error) {
+	return c.takeToken(ctx, key, limit, cost, time.Now())
+}
+
+func (c *Cache) takeToken(ctx context.Context, key string, limit Limit, cost int, now time.Time) (Decision, error) {
+	if key == "" || limit.Capacity <= 0 || limit.Rate <= 0 || math.IsInf(limit.Rate, 0) {
+		return Decision{}, fmt.Errorf("invalid rate limit for %q", key)
+	}
+	if cost <= 0 {
+		cost = 1
+	}
+
+	res, err := takeScript.Run(ctx, c.rdb, []string{rateLimitKey(key)},
+		limit.Capacity, limit.Rate, cost, now.UnixMilli()).Int64Slice()
+	if err != nil {
+		return Decision{}, err
+	}
+	if len(res) != 4 {
+		return Decision{}, fmt.Errorf("unexpected rate limit reply %v", res)
+	}
+	return Decision{
+		Allowed:    res[0] == 1,
+		Remaining:  int(res[1]),
+		RetryAfter: time.Duration(res[2]) * time.Millisecond,
+		ResetAfter: time.Duration(res[3]) * time.Millisecond,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTakeToken(t *testing.T) {
+	cache, cleanup := setupTestRedis(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	limit := Limit{Capacity: 3, Rate: 1}
+	now := time.UnixMilli(1_700_000_000_000)
+
+	t.Run("burst up to capacity", func(t *testing.T) {
+		for want := 2; want >= 0; want-- {
+			d, err := cache.takeToken(ctx, "login:ip:1", limit, 1, now)
+			require.NoError(t, err)
+			assert.True(t, d.Allowed)
+			assert.Equal(t, want, d.Remaining)
+		}
+
+		d, err := cache.takeToken(ctx, "login:ip:1", limit, 1, now)
+		require.NoError(t, err)
+		assert.False(t, d.Allowed)
+		assert.Equal(t, 0, d.Remaining)
+		assert.Equal(t, time.Second, d.RetryAfter)
+		assert.Equal(t, 3*time.Second, d.ResetAfter)
+	})
+
+	t.Run("refills over time", func(t *testing.T) {
+		d, err := cache.takeToken(ctx, "login:ip:1", limit, 1, now.Add(1500*time.Millisecond))
+		require.NoError(t, err)
+		assert.True(t, d.Allowed)
+		assert.Equal(t, 0, d.Remaining)
+
+		// Пополнение не превышает capacity
+		d, err = cache.takeToken(ctx, "login:ip:1", limit, 1, now.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 2, d.Remaining)
+	})
+
+	t.Run("keys are independent", func(t *testing.T) {
+		d, err := cache.takeToken(ctx, "login:ip:2", limit, 3, now)
+		require.NoError(t, err)
+		assert.True(t, d.Allowed)
+
+		d, err = cache.takeToken(ctx, "login:ip:3", limit, 4, now)
+		require.NoError(t, err)
+		assert.False(t, d.Allowed, "cost above capacity is never allowed")
+	})
+
+	t.Run("bucket expires once full", func(t *testing.T) {
+		_, err := cache.takeToken(ctx, "search:user:1", limit, 1, now)
+		require.NoError(t, err)
+		ttl := cache.rdb.PTTL(ctx, rateLimitKey("search:user:1")).Val()
+		assert.Equal(t, time.Second, ttl)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		_, err := cache.TakeToken(ctx, "x", Limit{Capacity: 0, Rate: 1}, 1)
+		assert.Error(t, err)
+		_, err = cache.TakeToken(ctx, "", limit, 1)
+		assert.Error(t, err)
+	})
+}
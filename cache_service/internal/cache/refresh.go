@@ -0,0 +1,182 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	ErrRefreshNotFound = errors.New("refresh token not found")
+	ErrRefreshReused   = errors.New("refresh token reused")
+)
+
+// RefreshToken - данные, которые хранятся вместе с refresh токеном
+type RefreshToken struct {
+	UserID int32
+	Login  string
+	Family string
+}
+
+func refreshKey(token string) string {
+	return "refresh:" + token
+}
+
+// familyKey - множество всех ключей (refresh и access), выпущенных в одном семействе
+func familyKey(family string) string {
+	return "refresh_family:" + family
+}
+
+// extendTTL продлевает ttl ключа-индекса, но никогда его не сокращает
+func extendTTL(ctx context.Context, pipe redis.Pipeliner, key string, dl time.Duration) {
+	pipe.ExpireNX(ctx, key, dl)
+	pipe.ExpireGT(ctx, key, dl)
+}
+
+func writeRefresh(ctx context.Context, pipe redis.Pipeliner, token string, rt RefreshToken, dl time.Duration) {
+	key := refreshKey(token)
+	pipe.HSet(ctx, key, map[string]interface{}{
+		"login":   rt.Login,
+		"user_id": rt.UserID,
+		"family":  rt.Family,
+		"used":    0,
+	})
+	pipe.Expire(ctx, key, dl)
+	pipe.SAdd(ctx, familyKey(rt.Family), key)
+	extendTTL(ctx, pipe, familyKey(rt.Family), dl)
+	uKey := userKey(strconv.Itoa(int(rt.UserID)))
+	pipe.SAdd(ctx, uKey, key)
+	extendTTL(ctx, pipe, uKey, dl)
+}
+
+// SetRefreshToken сохраняет refresh токен, открывающий новое семейство
+func (c *Cache) SetRefreshToken(ctx context.Context, token string, rt RefreshToken, dl time.Duration) error {
+	if token == "" || rt.Family == "" {
+		return fmt.Errorf("empty refresh token or family")
+	}
+	_, err := c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		writeRefresh(ctx, pipe, token, rt, dl)
+		return nil
+	})
+	return err
+}
+
+// AddToFamily привязывает access токен к семейству, чтобы отозвать его вместе с семейством
+func (c *Cache) AddToFamily(ctx context.Context, family, hash string, dl time.Duration) error {
+	_, err := c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, hash, "family", family)
+		pipe.SAdd(ctx, familyKey(family), hash)
+		extendTTL(ctx, pipe, familyKey(family), dl)
+		return nil
+	})
+	return err
+}
+
+// RotateRefreshToken одноразово меняет old на next. Повторное предъявление уже
+// использованного токена считается кражей: семейство отзывается целиком.
+func (c *Cache) RotateRefreshToken(ctx context.Context, old, next string, dl time.Duration) (RefreshToken, error) {
+	key := refreshKey(old)
+	rt, err := readRefresh(ctx, c.rdb, key)
+	if err == ErrRefreshReused {
+		return rt, c.revokeReused(ctx, rt)
+	}
+	if err != nil {
+		return rt, err
+	}
+
+	// Новый токен пишется до погашения старого: если процесс упадёт между
+	// шагами, старый токен останется рабочим и клиент просто повторит обмен
+	_, err = c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		writeRefresh(ctx, pipe, next, rt, dl)
+		return nil
+	})
+	if err != nil {
+		return rt, err
+	}
+
+	// В транзакции только ключ старого токена: в кластере WATCH и MULTI
+	// работают в пределах одного слота
+	err = c.rdb.Watch(ctx, func(tx *redis.Tx) error {
+		if _, err := readRefresh(ctx, tx, key); err != nil {
+			return err
+		}
+		_, err := tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HSet(ctx, key, "used", 1)
+			return nil
+		})
+		return err
+	}, key)
+	if err == nil {
+		return rt, nil
+	}
+
+	// Старый токен не погашен, выпущенный по нему новый не должен остаться рабочим
+	if dropErr := c.dropRefresh(ctx, next, rt); dropErr != nil {
+		return rt, dropErr
+	}
+	// Параллельная ротация того же токена - тоже повторное использование
+	if errors.Is(err, redis.TxFailedErr) || err == ErrRefreshReused {
+		return rt, c.revokeReused(ctx, rt)
+	}
+	return rt, err
+}
+
+// readRefresh читает refresh токен; ErrRefreshReused - токен уже погашен
+func readRefresh(ctx context.Context, cmd redis.Cmdable, key string) (RefreshToken, error) {
+	val, err := cmd.HGetAll(ctx, key).Result()
+	if err != nil {
+		return RefreshToken{}, err
+	}
+	if len(val) == 0 {
+		return RefreshToken{}, ErrRefreshNotFound
+	}
+	userID, _ := strconv.Atoi(val["user_id"])
+	rt := RefreshToken{UserID: int32(userID), Login: val["login"], Family: val["family"]}
+	if val["used"] == "1" {
+		return rt, ErrRefreshReused
+	}
+	return rt, nil
+}
+
+// dropRefresh удаляет refresh токен вместе с записями о нём в индексах
+func (c *Cache) dropRefresh(ctx context.Context, token string, rt RefreshToken) error {
+	key := refreshKey(token)
+	_, err := c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.SRem(ctx, familyKey(rt.Family), key)
+		pipe.SRem(ctx, userKey(strconv.Itoa(int(rt.UserID))), key)
+		return nil
+	})
+	return err
+}
+
+// revokeReused отзывает семейство повторно предъявленного токена
+func (c *Cache) revokeReused(ctx context.Context, rt RefreshToken) error {
+	if _, err := c.RevokeFamily(ctx, rt.Family); err != nil {
+		return err
+	}
+	return ErrRefreshReused
+}
+
+// RevokeFamily удаляет все refresh и access токены семейства
+func (c *Cache) RevokeFamily(ctx context.Context, family string) (int, error) {
+	if family == "" {
+		return 0, nil
+	}
+	keys, err := c.rdb.SMembers(ctx, familyKey(family)).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	pipe := c.rdb.TxPipeline()
+	deleted := delEach(ctx, pipe, keys)
+	pipe.Del(ctx, familyKey(family))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return countDeleted(deleted), nil
+}
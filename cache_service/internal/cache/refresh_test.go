@@ -0,0 +1,105 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateRefreshToken(t *testing.T) {
+	cache, cleanup := setupTestRedis(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	rt := RefreshToken{UserID: 5, Login: "user", Family: "family-1"}
+
+	t.Run("successful rotation", func(t *testing.T) {
+		require.NoError(t, cache.SetRefreshToken(ctx, "rt-1", rt, time.Hour))
+
+		got, err := cache.RotateRefreshToken(ctx, "rt-1", "rt-2", time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, rt, got)
+
+		// Новый токен принадлежит тому же семейству
+		got, err = cache.RotateRefreshToken(ctx, "rt-2", "rt-3", time.Hour)
+		assert.NoError(t, err)
+		assert.Equal(t, "family-1", got.Family)
+	})
+
+	t.Run("reuse revokes family", func(t *testing.T) {
+		saveSession(t, cache, "access-jwt", 5)
+		require.NoError(t, cache.AddToFamily(ctx, "family-1", "access-jwt", time.Hour))
+
+		_, err := cache.RotateRefreshToken(ctx, "rt-1", "rt-4", time.Hour)
+		assert.ErrorIs(t, err, ErrRefreshReused)
+
+		// Отозваны и последний выданный refresh токен, и access токен семейства
+		_, err = cache.RotateRefreshToken(ctx, "rt-3", "rt-5", time.Hour)
+		assert.ErrorIs(t, err, ErrRefreshNotFound)
+		assert.False(t, exists(t, cache, "access-jwt"))
+		assert.False(t, exists(t, cache, refreshKey("rt-4")))
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		_, err := cache.RotateRefreshToken(ctx, "missing", "rt-6", time.Hour)
+		assert.ErrorIs(t, err, ErrRefreshNotFound)
+	})
+
+	t.Run("empty family", func(t *testing.T) {
+		err := cache.SetRefreshToken(ctx, "rt-7", RefreshToken{UserID: 5}, time.Hour)
+		assert.Error(t, err)
+	})
+}
+
+func TestRotateRefreshTokenConcurrent(t *testing.T) {
+	cache, cleanup := setupTestRedis(t)
+	defer cleanup()
+	ctx := context.Background()
+	const clients = 20
+
+	require.NoError(t, cache.SetRefreshToken(ctx, "rt-1", RefreshToken{UserID: 5, Family: "family-1"}, time.Hour))
+
+	var wg sync.WaitGroup
+	errs := make([]error, clients)
+	for i := 0; i < clients; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = cache.RotateRefreshToken(ctx, "rt-1", fmt.Sprintf("next-%d", i), time.Hour)
+		}(i)
+	}
+	wg.Wait()
+
+	rotated := 0
+	for i, err := range errs {
+		if err == nil {
+			rotated++
+			continue
+		}
+		assert.ErrorIs(t, err, ErrRefreshReused)
+		// Токен проигравшей ротации не должен остаться рабочим
+		assert.False(t, exists(t, cache, refreshKey(fmt.Sprintf("next-%d", i))))
+	}
+	assert.Equal(t, 1, rotated)
+}
+
+func TestDeleteRevokesFamily(t *testing.T) {
+	cache, cleanup := setupTestRedis(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	require.NoError(t, cache.SetRefreshToken(ctx, "rt-1", RefreshToken{UserID: 8, Login: "user", Family: "f"}, time.Hour))
+	saveSession(t, cache, "access-jwt", 8)
+	require.NoError(t, cache.AddToFamily(ctx, "f", "access-jwt", time.Hour))
+
+	require.NoError(t, cache.Delete(ctx, "access-jwt"))
+
+	_, err := cache.RotateRefreshToken(ctx, "rt-1", "rt-2", time.Hour)
+	assert.ErrorIs(t, err, ErrRefreshNotFound)
+}
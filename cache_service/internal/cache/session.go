@@ -0,0 +1,342 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+	// ErrSessionConflict - ключ уже занят сессией другого пользователя
+	ErrSessionConflict = errors.New("session belongs to another user")
+	// ErrSessionExpired - абсолютный срок сессии уже вышел
+	ErrSessionExpired = errors.New("session lifetime is over")
+)
+
+// maxUserAgentLen ограничивает User-Agent, который клиент может прислать любым
+const maxUserAgentLen = 256
+
+// SessionPolicy - сроки жизни сессий
+type SessionPolicy struct {
+	// TTL - срок сессии, если при записи не передан свой
+	TTL time.Duration
+	// IdleTimeout - продление сессии при каждом обращении, 0 - без продления
+	IdleTimeout time.Duration
+	// MaxLifetime - абсолютный срок с момента создания сессии
+	MaxLifetime time.Duration
+}
+
+// Session - сессия (устройство) пользователя. Ключ в Redis - сам access токен,
+// наружу отдаётся только ID.
+type Session struct {
+	ID        string
+	UserID    int32
+	Login     string
+	Family    string
+	CreatedAt time.Time
+	LastSeen  time.Time
+	UserAgent string
+	IP        string
+	// ExpiresAt - когда сессия истечёт без продления
+	ExpiresAt time.Time
+	// Deadline - абсолютный срок, нулевой у записей до его появления
+	Deadline time.Time
+	// Token - ключ сессии в Redis, через ListSessions клиентам не отдаётся
+	Token string
+}
+
+func sessionFromHash(token string, val map[string]string) Session {
+	userID, _ := strconv.Atoi(val["user_id"])
+	return Session{
+		ID:        val["session_id"],
+		UserID:    int32(userID),
+		Login:     val["login"],
+		Family:    val["family"],
+		CreatedAt: unixField(val["created_at"]),
+		LastSeen:  unixField(val["last_seen"]),
+		UserAgent: val["user_agent"],
+		IP:        val["ip"],
+		Deadline:  unixField(val["deadline"]),
+		Token:     token,
+	}
+}
+
+// randomID - непрозрачный ID сессии, по нему нельзя восстановить токен
+func randomID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func unixField(v string) time.Time {
+	sec, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || sec == 0 {
+		return time.Time{}
+	}
+	return time.Unix(sec, 0)
+}
+
+// saveScript проверяет владельца и записывает сессию одной операцией: между
+// чтением и записью никто не успеет занять ключ, а ключ не останется без ttl.
+// Возвращает {-1} при чужой сессии, {-2} если абсолютный срок вышел, иначе
+// {создана (0/1), id, created_at, deadline, ttl (мс)}.
+//
+// KEYS[1] - сессия
+// ARGV: user_id, новый id, now (с), ttl (мс), idle timeout (мс), max lifetime (с),
+// login, user_agent, ip
+var saveScript = redis.NewScript(`
+local now = tonumber(ARGV[3])
+local ttl = tonumber(ARGV[4])
+local idle = tonumber(ARGV[5])
+local maxLifetime = tonumber(ARGV[6])
+
+local cur = redis.call('HMGET', KEYS[1], 'user_id', 'session_id', 'created_at', 'deadline')
+local id, created, deadline, isNew = ARGV[2], now, 0, 1
+if cur[1] then
+	if cur[1] ~= ARGV[1] then
+		return {-1}
+	end
+	isNew = 0
+	-- Записи до появления ID сессий получают его при первой перезаписи
+	if cur[2] and cur[2] ~= '' then
+		id = cur[2]
+	end
+	created = tonumber(cur[3]) or 0
+	if created == 0 then
+		created = now
+	end
+	deadline = tonumber(cur[4]) or 0
+end
+if deadline == 0 and maxLifetime > 0 then
+	deadline = created + maxLifetime
+end
+
+if idle > 0 and idle < ttl then
+	ttl = idle
+end
+if deadline > 0 then
+	local rest = (deadline - now) * 1000
+	if rest <= 0 then
+		return {-2}
+	end
+	if rest < ttl then
+		ttl = rest
+	end
+end
+
+redis.call('HSET', KEYS[1], 'session_id', id, 'login', ARGV[7], 'user_id', ARGV[1],
+	'created_at', created, 'last_seen', now, 'user_agent', ARGV[8], 'ip', ARGV[9], 'deadline', deadline)
+redis.call('PEXPIRE', KEYS[1], ttl)
+return {isNew, id, created, deadline, ttl}
+`)
+
+// SaveSession сохраняет сессию под ключом token. Запись идемпотентна: клиент
+// повторяет Write, если не дождался ответа, и повтор обновляет уже записанную
+// сессию, сохраняя её ID и время создания. Срок сессии - ttl (p.TTL, если 0),
+// но не больше p.IdleTimeout и остатка p.MaxLifetime. Второй результат - создана
+// ли сессия заново.
+func (c *Cache) SaveSession(ctx context.Context, token string, s Session, ttl time.Duration, p SessionPolicy) (Session, bool, error) {
+	if token == "" {
+		return Session{}, false, fmt.Errorf("empty jwt")
+	}
+	if len(s.UserAgent) > maxUserAgentLen {
+		s.UserAgent = s.UserAgent[:maxUserAgentLen]
+	}
+	if ttl <= 0 {
+		ttl = p.TTL
+	}
+	newID, err := randomID()
+	if err != nil {
+		return Session{}, false, err
+	}
+	// В Redis время хранится в секундах, возвращаем его в том же виде
+	now := time.Unix(time.Now().Unix(), 0)
+
+	res, err := saveScript.Run(ctx, c.rdb, []string{token},
+		s.UserID, newID, now.Unix(), ttl.Milliseconds(), p.IdleTimeout.Milliseconds(),
+		int64(p.MaxLifetime.Seconds()), s.Login, s.UserAgent, s.IP,
+	).Slice()
+	if err != nil {
+		return Session{}, false, err
+	}
+	switch code, _ := res[0].(int64); code {
+	case -1:
+		return Session{}, false, ErrSessionConflict
+	case -2:
+		return Session{}, false, ErrSessionExpired
+	}
+	if len(res) != 5 {
+		return Session{}, false, fmt.Errorf("unexpected save session reply %v", res)
+	}
+	created := res[0].(int64) == 1
+	s.ID, _ = res[1].(string)
+	createdAt, _ := res[2].(int64)
+	deadline, _ := res[3].(int64)
+	sessionTTL, _ := res[4].(int64)
+
+	s.Token = token
+	s.CreatedAt = time.Unix(createdAt, 0)
+	s.LastSeen = now
+	if deadline > 0 {
+		s.Deadline = time.Unix(deadline, 0)
+	}
+	s.ExpiresAt = now.Add(time.Duration(sessionTTL) * time.Millisecond)
+
+	// Индекс пользователя должен пережить сессию, даже если её будут продлевать.
+	// Он живёт в другом слоте Redis Cluster, поэтому пишется отдельной транзакцией;
+	// лишние записи в нём ListSessions вычищает сам.
+	indexTTL := s.ExpiresAt.Sub(now)
+	if p.IdleTimeout > 0 && !s.Deadline.IsZero() {
+		indexTTL = s.Deadline.Sub(now)
+	}
+	uKey := userKey(strconv.Itoa(int(s.UserID)))
+	_, err = c.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SAdd(ctx, uKey, token)
+		extendTTL(ctx, pipe, uKey, indexTTL)
+		return nil
+	})
+	if err != nil {
+		return Session{}, false, err
+	}
+	return s, created, nil
+}
+
+// GetSession читает сессию по токену
+func (c *Cache) GetSession(ctx context.Context, token string) (Session, error) {
+	val, err := c.rdb.HGetAll(ctx, token).Result()
+	if err != nil {
+		return Session{}, err
+	}
+	if len(val) == 0 {
+		return Session{}, ErrSessionNotFound
+	}
+	return sessionFromHash(token, val), nil
+}
+
+// touchScript обновляет last_seen и, если задан idle timeout, продлевает сессию,
+// но не дальше её абсолютного срока. Работает только с существующей сессией:
+// HSET по истёкшему ключу создал бы хэш без ttl. Возвращает PTTL сессии.
+//
+// KEYS[1] - сессия; ARGV: now (мс), idle timeout (мс, 0 - без продления)
+var touchScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local idle = tonumber(ARGV[2])
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return -2
+end
+redis.call('HSET', KEYS[1], 'last_seen', math.floor(now / 1000))
+if idle == 0 then
+	return redis.call('PTTL', KEYS[1])
+end
+
+local expires = now + idle
+local deadline = tonumber(redis.call('HGET', KEYS[1], 'deadline') or '0') * 1000
+if deadline > 0 and deadline < expires then
+	expires = deadline
+end
+if expires <= now then
+	redis.call('DEL', KEYS[1])
+	return -2
+end
+redis.call('PEXPIREAT', KEYS[1], expires)
+return expires - now
+`)
+
+// Touch отмечает использование сессии и возвращает, когда она истечёт
+// (нулевое время - ключ без ttl)
+func (c *Cache) Touch(ctx context.Context, token string, idle time.Duration) (time.Time, error) {
+	now := time.Now()
+	pttl, err := touchScript.Run(ctx, c.rdb, []string{token}, now.UnixMilli(), idle.Milliseconds()).Int64()
+	if err != nil {
+		return time.Time{}, err
+	}
+	switch {
+	case pttl == -2:
+		return time.Time{}, ErrSessionNotFound
+	case pttl < 0:
+		return time.Time{}, nil
+	}
+	return now.Add(time.Duration(pttl) * time.Millisecond), nil
+}
+
+// ListSessions возвращает активные сессии пользователя, последние использованные первыми.
+// Истёкшие ключи заодно удаляются из индекса пользователя.
+func (c *Cache) ListSessions(ctx context.Context, userID int32) ([]Session, error) {
+	uKey := userKey(strconv.Itoa(int(userID)))
+	members, err := c.rdb.SMembers(ctx, uKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens []string
+	for _, m := range members {
+		// В индексе лежат и refresh токены, сессиями они не являются
+		if !strings.HasPrefix(m, refreshKey("")) {
+			tokens = append(tokens, m)
+		}
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	}
+
+	cmds := make([]*redis.MapStringStringCmd, len(tokens))
+	_, err = c.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, token := range tokens {
+			cmds[i] = pipe.HGetAll(ctx, token)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]Session, 0, len(tokens))
+	var stale []interface{}
+	for i, cmd := range cmds {
+		if len(cmd.Val()) == 0 {
+			stale = append(stale, tokens[i])
+			continue
+		}
+		s := sessionFromHash(tokens[i], cmd.Val())
+		// Записи без ID созданы до появления списка устройств и истекут сами
+		if s.UserID == userID && s.ID != "" {
+			sessions = append(sessions, s)
+		}
+	}
+	if len(stale) > 0 {
+		_ = c.rdb.SRem(ctx, uKey, stale...).Err()
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeen.After(sessions[j].LastSeen)
+	})
+	return sessions, nil
+}
+
+// RevokeSession удаляет сессию пользователя по её ID вместе с семейством refresh токенов
+func (c *Cache) RevokeSession(ctx context.Context, userID int32, sessionID string) error {
+	if sessionID == "" {
+		return ErrSessionNotFound
+	}
+	sessions, err := c.ListSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, s := range sessions {
+		if s.ID == sessionID {
+			return c.Delete(ctx, s.Token)
+		}
+	}
+	return ErrSessionNotFound
+}
@@ -0,0 +1,214 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSessions = SessionPolicy{TTL: time.Hour, MaxLifetime: 24 * time.Hour}
+
+func TestSaveSession(t *testing.T) {
+	cache, cleanup := setupTestRedis(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	s, created, err := cache.SaveSession(ctx, "jwt-1", Session{UserID: 7, Login: "alice", UserAgent: "curl/8", IP: "203.0.113.5"}, time.Hour, testSessions)
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Len(t, s.ID, 32)
+
+	// Повторная запись того же токена сохраняет ID и обновляет данные устройства
+	again, created, err := cache.SaveSession(ctx, "jwt-1", Session{UserID: 7, Login: "alice", UserAgent: "firefox"}, time.Hour, testSessions)
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, s.ID, again.ID)
+	assert.Equal(t, s.CreatedAt, again.CreatedAt)
+
+	got, err := cache.GetSession(ctx, "jwt-1")
+	require.NoError(t, err)
+	assert.Equal(t, "firefox", got.UserAgent)
+	assert.Equal(t, int32(7), got.UserID)
+
+	_, _, err = cache.SaveSession(ctx, "jwt-1", Session{UserID: 8, Login: "bob"}, time.Hour, testSessions)
+	assert.ErrorIs(t, err, ErrSessionConflict)
+
+	_, err = cache.GetSession(ctx, "missing")
+	assert.ErrorIs(t, err, ErrSessionNotFound)
+}
+
+func TestListAndRevokeSessions(t *testing.T) {
+	cache, cleanup := setupTestRedis(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	first, _, err := cache.SaveSession(ctx, "jwt-1", Session{UserID: 7, Login: "alice"}, time.Hour, testSessions)
+	require.NoError(t, err)
+	second, _, err := cache.SaveSession(ctx, "jwt-2", Session{UserID: 7, Login: "alice"}, time.Hour, testSessions)
+	require.NoError(t, err)
+	require.NoError(t, cache.rdb.HSet(ctx, "jwt-2", "last_seen", first.LastSeen.Unix()+10).Err())
+	require.NoError(t, cache.SetRefreshToken(ctx, "rt-1", RefreshToken{UserID: 7, Login: "alice", Family: "fam"}, time.Hour))
+	// Истёкший ключ остаётся в индексе пользователя
+	require.NoError(t, cache.rdb.SAdd(ctx, userKey("7"), "jwt-expired").Err())
+
+	sessions, err := cache.ListSessions(ctx, 7)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, second.ID, sessions[0].ID)
+	assert.Equal(t, first.ID, sessions[1].ID)
+	isMember, _ := cache.rdb.SIsMember(ctx, userKey("7"), "jwt-expired").Result()
+	assert.False(t, isMember)
+
+	assert.ErrorIs(t, cache.RevokeSession(ctx, 8, first.ID), ErrSessionNotFound)
+	require.NoError(t, cache.RevokeSession(ctx, 7, first.ID))
+	assert.False(t, exists(t, cache, "jwt-1"))
+	assert.ErrorIs(t, cache.RevokeSession(ctx, 7, first.ID), ErrSessionNotFound)
+
+	sessions, err = cache.ListSessions(ctx, 7)
+	require.NoError(t, err)
+	assert.Len(t, sessions, 1)
+}
+
+func TestTouch(t *testing.T) {
+	cache, cleanup := setupTestRedis(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	_, err := cache.Touch(ctx, "missing", 0)
+	assert.ErrorIs(t, err, ErrSessionNotFound)
+	n, _ := cache.rdb.Exists(ctx, "missing").Result()
+	assert.Zero(t, n)
+
+	saved, _, err := cache.SaveSession(ctx, "jwt-1", Session{UserID: 7}, time.Hour, testSessions)
+	require.NoError(t, err)
+	assert.Equal(t, saved.CreatedAt.Add(24*time.Hour), saved.Deadline)
+	require.NoError(t, cache.rdb.HSet(ctx, "jwt-1", "last_seen", 1).Err())
+
+	// Без idle timeout срок не меняется
+	expiresAt, err := cache.Touch(ctx, "jwt-1", 0)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, 2*time.Second)
+	s, err := cache.GetSession(ctx, "jwt-1")
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), s.LastSeen, 2*time.Second)
+}
+
+func TestSlidingExpiry(t *testing.T) {
+	cache, cleanup := setupTestRedis(t)
+	defer cleanup()
+	ctx := context.Background()
+	policy := SessionPolicy{TTL: time.Hour, IdleTimeout: 10 * time.Minute, MaxLifetime: time.Hour}
+
+	// Первый срок - idle timeout, а индекс пользователя живёт до абсолютного срока
+	saved, _, err := cache.SaveSession(ctx, "jwt-1", Session{UserID: 7}, time.Hour, policy)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), saved.ExpiresAt, 2*time.Second)
+	assert.InDelta(t, 10*time.Minute, cache.rdb.TTL(ctx, "jwt-1").Val(), float64(2*time.Second))
+	assert.InDelta(t, time.Hour, cache.rdb.TTL(ctx, userKey("7")).Val(), float64(2*time.Second))
+
+	// Продление не выходит за абсолютный срок
+	deadline := time.Now().Add(5 * time.Minute).Unix()
+	require.NoError(t, cache.rdb.HSet(ctx, "jwt-1", "deadline", deadline).Err())
+	expiresAt, err := cache.Touch(ctx, "jwt-1", policy.IdleTimeout)
+	require.NoError(t, err)
+	assert.Equal(t, deadline, expiresAt.Unix())
+
+	// После абсолютного срока сессия удаляется и не перезаписывается
+	require.NoError(t, cache.rdb.HSet(ctx, "jwt-1", "deadline", time.Now().Add(-time.Second).Unix()).Err())
+	_, _, err = cache.SaveSession(ctx, "jwt-1", Session{UserID: 7}, time.Hour, policy)
+	assert.ErrorIs(t, err, ErrSessionExpired)
+	_, err = cache.Touch(ctx, "jwt-1", policy.IdleTimeout)
+	assert.ErrorIs(t, err, ErrSessionNotFound)
+	assert.False(t, exists(t, cache, "jwt-1"))
+}
+
+func TestSaveSessionConcurrent(t *testing.T) {
+	cache, cleanup := setupTestRedis(t)
+	defer cleanup()
+	ctx := context.Background()
+	const writers = 20
+
+	t.Run("same user keeps one session", func(t *testing.T) {
+		var wg sync.WaitGroup
+		ids := make([]string, writers)
+		created := make([]bool, writers)
+		for i := 0; i < writers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				s, c, err := cache.SaveSession(ctx, "jwt-same", Session{UserID: 7, Login: "alice"}, time.Hour, testSessions)
+				assert.NoError(t, err)
+				ids[i], created[i] = s.ID, c
+			}(i)
+		}
+		wg.Wait()
+
+		fresh := 0
+		for i := range ids {
+			assert.Equal(t, ids[0], ids[i])
+			if created[i] {
+				fresh++
+			}
+		}
+		assert.Equal(t, 1, fresh)
+	})
+
+	t.Run("only one user takes the key", func(t *testing.T) {
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		var winners []int32
+		for i := 0; i < writers; i++ {
+			wg.Add(1)
+			go func(userID int32) {
+				defer wg.Done()
+				_, _, err := cache.SaveSession(ctx, "jwt-contended", Session{UserID: userID}, time.Hour, testSessions)
+				if err == nil {
+					mu.Lock()
+					winners = append(winners, userID)
+					mu.Unlock()
+					return
+				}
+				assert.ErrorIs(t, err, ErrSessionConflict)
+			}(int32(100 + i))
+		}
+		wg.Wait()
+
+		require.Len(t, winners, 1)
+		s, err := cache.GetSession(ctx, "jwt-contended")
+		require.NoError(t, err)
+		assert.Equal(t, winners[0], s.UserID)
+	})
+}
+
+func TestTouchRacesDelete(t *testing.T) {
+	cache, cleanup := setupTestRedis(t)
+	defer cleanup()
+	ctx := context.Background()
+	policy := SessionPolicy{TTL: time.Hour, IdleTimeout: 10 * time.Minute, MaxLifetime: time.Hour}
+
+	for i := 0; i < 50; i++ {
+		token := fmt.Sprintf("jwt-%d", i)
+		_, _, err := cache.SaveSession(ctx, token, Session{UserID: 7}, time.Hour, policy)
+		require.NoError(t, err)
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, cache.Delete(ctx, token))
+		}()
+		go func() {
+			defer wg.Done()
+			_, _ = cache.Touch(ctx, token, policy.IdleTimeout)
+		}()
+		wg.Wait()
+
+		// Продление после удаления не должно воскресить сессию без ttl
+		assert.False(t, exists(t, cache, token), token)
+	}
+}
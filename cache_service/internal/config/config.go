@@ -0,0 +1,288 @@
+// Package config собирает настройки cache_service из значений по умолчанию,
+// YAML файла, переменных окружения и флагов (в порядке возрастания приоритета)
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"platform/configload"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+type Server struct {
+	// GRPCAddr - адрес CacheService
+	GRPCAddr string `yaml:"grpc_addr"`
+	// MetricsAddr - /metrics и /health
+	MetricsAddr string `yaml:"metrics_addr"`
+	// ShutdownTimeout - ограничение на GracefulStop, после него соединения рвутся
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+type Redis struct {
+	// Mode - standalone, sentinel (отказоустойчивость через Sentinel) или cluster
+	Mode string `yaml:"mode"`
+	// Addr - адрес Redis; для sentinel - адреса sentinel, для cluster - seed узлы, через запятую
+	Addr string `yaml:"addr"`
+	// MasterName - имя мастера, за которым следят sentinel
+	MasterName string `yaml:"master_name"`
+	// Username и Password - ACL пользователь; без Username AUTH только паролем
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	// SentinelUsername и SentinelPassword - учётные данные самих sentinel
+	SentinelUsername string `yaml:"sentinel_username"`
+	SentinelPassword string `yaml:"sentinel_password"`
+	// DB - номер базы, в cluster доступна только 0
+	DB  int      `yaml:"db"`
+	TLS RedisTLS `yaml:"tls"`
+	// BackoffBase и BackoffMax - задержки между попытками подключения на старте
+	BackoffBase time.Duration `yaml:"backoff_base"`
+	BackoffMax  time.Duration `yaml:"backoff_max"`
+}
+
+// RedisTLS - TLS до Redis и sentinel
+type RedisTLS struct {
+	Enabled bool `yaml:"enabled"`
+	// CAFile - корневые сертификаты сервера, без него используются системные
+	CAFile string `yaml:"ca_file"`
+	// CertFile и KeyFile - клиентский сертификат для mTLS
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// ServerName - имя для проверки сертификата, если оно не совпадает с адресом
+	ServerName string `yaml:"server_name"`
+}
+
+// Addrs разбирает список адресов из Addr
+func (r Redis) Addrs() []string {
+	var addrs []string
+	for _, a := range strings.Split(r.Addr, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			addrs = append(addrs, a)
+		}
+	}
+	return addrs
+}
+
+// Health - проверка Redis для /readyz и grpc.health.v1
+type Health struct {
+	Timeout  time.Duration `yaml:"timeout"`
+	CacheTTL time.Duration `yaml:"cache_ttl"`
+	// Interval - период проверки Redis для статуса grpc.health.v1
+	Interval time.Duration `yaml:"interval"`
+}
+
+// Tracing - экспорт спанов OpenTelemetry
+type Tracing struct {
+	// Exporter - none, otlp (gRPC на Endpoint), stdout или file (JSON в File)
+	Exporter string `yaml:"exporter"`
+	Endpoint string `yaml:"endpoint"`
+	File     string `yaml:"file"`
+	// SampleRatio - доля новых трасс, которые записываются (0..1)
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
+// Log - логи сервиса
+type Log struct {
+	// Level - debug, info, warn или error
+	Level string `yaml:"level"`
+	// Format - json или text
+	Format string `yaml:"format"`
+}
+
+// Login - блокировка входа после серии неудачных попыток
+type Login struct {
+	// Window - скользящее окно, в котором считаются неудачные попытки
+	Window time.Duration `yaml:"window"`
+	// MaxUserFailures и MaxIPFailures - сколько неудач в окне допускается до блокировки
+	MaxUserFailures int `yaml:"max_user_failures"`
+	MaxIPFailures   int `yaml:"max_ip_failures"`
+	// LockoutBase - первая блокировка, каждая следующая вдвое длиннее, но не больше LockoutMax
+	LockoutBase time.Duration `yaml:"lockout_base"`
+	LockoutMax  time.Duration `yaml:"lockout_max"`
+}
+
+// Session - сроки жизни сессий (access токенов) в Redis
+type Session struct {
+	// TTL - срок сессии, если api_service не передал свой
+	TTL time.Duration `yaml:"ttl"`
+	// IdleTimeout - если задан, каждый GetUser продлевает сессию на это время
+	IdleTimeout time.Duration `yaml:"idle_timeout"`
+	// MaxLifetime - абсолютный срок с момента входа, продление его не превышает
+	MaxLifetime time.Duration `yaml:"max_lifetime"`
+}
+
+type Config struct {
+	Server  Server  `yaml:"server"`
+	Redis   Redis   `yaml:"redis"`
+	Health  Health  `yaml:"health"`
+	Tracing Tracing `yaml:"tracing"`
+	Log     Log     `yaml:"log"`
+	Login   Login   `yaml:"login"`
+	Session Session `yaml:"session"`
+
+	// PrintOnly - запрошен --print-config: вывести конфигурацию и выйти
+	PrintOnly bool `yaml:"-"`
+}
+
+// Default - значения для docker-compose
+func Default() *Config {
+	return &Config{
+		Server: Server{
+			GRPCAddr:        ":50053",
+			MetricsAddr:     ":8052",
+			ShutdownTimeout: 10 * time.Second,
+		},
+		Redis: Redis{
+			Mode:        "standalone",
+			Addr:        "cache:6379",
+			Password:    "admin",
+			BackoffBase: 250 * time.Millisecond,
+			BackoffMax:  5 * time.Second,
+		},
+		Health: Health{
+			Timeout:  2 * time.Second,
+			CacheTTL: 5 * time.Second,
+			Interval: 5 * time.Second,
+		},
+		Tracing: Tracing{
+			Exporter:    "none",
+			SampleRatio: 1,
+		},
+		Log: Log{
+			Level:  "info",
+			Format: "json",
+		},
+		Login: Login{
+			Window:          15 * time.Minute,
+			MaxUserFailures: 5,
+			MaxIPFailures:   20,
+			LockoutBase:     time.Minute,
+			LockoutMax:      time.Hour,
+		},
+		Session: Session{
+			TTL:         24 * time.Hour,
+			MaxLifetime: 24 * time.Hour,
+		},
+	}
+}
+
+// Validate проверяет конфигурацию и возвращает все ошибки сразу
+func (c *Config) Validate() error {
+	var errs []error
+	if c.Server.GRPCAddr == "" {
+		errs = append(errs, errors.New("server.grpc_addr is required"))
+	}
+	if c.Server.MetricsAddr == "" {
+		errs = append(errs, errors.New("server.metrics_addr is required"))
+	}
+	if c.Server.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("server.shutdown_timeout must be positive"))
+	}
+	addrs := c.Redis.Addrs()
+	if len(addrs) == 0 {
+		errs = append(errs, errors.New("redis.addr is required"))
+	}
+	if c.Redis.DB < 0 {
+		errs = append(errs, errors.New("redis.db must not be negative"))
+	}
+	switch c.Redis.Mode {
+	case "standalone":
+		if len(addrs) > 1 {
+			errs = append(errs, errors.New("redis.addr must be a single address in standalone mode"))
+		}
+	case "sentinel":
+		if c.Redis.MasterName == "" {
+			errs = append(errs, errors.New("redis.master_name is required in sentinel mode"))
+		}
+	case "cluster":
+		if c.Redis.DB != 0 {
+			errs = append(errs, errors.New("redis.db must be 0 in cluster mode"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("redis.mode must be standalone, sentinel or cluster, got %q", c.Redis.Mode))
+	}
+	if (c.Redis.TLS.CertFile == "") != (c.Redis.TLS.KeyFile == "") {
+		errs = append(errs, errors.New("redis.tls.cert_file and redis.tls.key_file must be set together"))
+	}
+	if !c.Redis.TLS.Enabled && (c.Redis.TLS.CAFile != "" || c.Redis.TLS.CertFile != "") {
+		errs = append(errs, errors.New("redis.tls.enabled is required to use TLS files"))
+	}
+	if c.Redis.BackoffBase <= 0 {
+		errs = append(errs, errors.New("redis.backoff_base must be positive"))
+	}
+	if c.Redis.BackoffMax < c.Redis.BackoffBase {
+		errs = append(errs, errors.New("redis.backoff_max must not be less than redis.backoff_base"))
+	}
+	if c.Health.Timeout <= 0 {
+		errs = append(errs, errors.New("health.timeout must be positive"))
+	}
+	if c.Health.CacheTTL < 0 {
+		errs = append(errs, errors.New("health.cache_ttl must not be negative"))
+	}
+	if c.Health.Interval <= 0 {
+		errs = append(errs, errors.New("health.interval must be positive"))
+	}
+	if c.Login.Window <= 0 {
+		errs = append(errs, errors.New("login.window must be positive"))
+	}
+	if c.Login.MaxUserFailures <= 0 || c.Login.MaxIPFailures <= 0 {
+		errs = append(errs, errors.New("login.max_user_failures and login.max_ip_failures must be positive"))
+	}
+	if c.Login.LockoutBase <= 0 {
+		errs = append(errs, errors.New("login.lockout_base must be positive"))
+	}
+	if c.Login.LockoutMax < c.Login.LockoutBase {
+		errs = append(errs, errors.New("login.lockout_max must not be less than login.lockout_base"))
+	}
+	if c.Session.TTL <= 0 {
+		errs = append(errs, errors.New("session.ttl must be positive"))
+	}
+	if c.Session.IdleTimeout < 0 {
+		errs = append(errs, errors.New("session.idle_timeout must not be negative"))
+	}
+	if c.Session.MaxLifetime < c.Session.TTL || c.Session.MaxLifetime < c.Session.IdleTimeout {
+		errs = append(errs, errors.New("session.max_lifetime must not be less than session.ttl and session.idle_timeout"))
+	}
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		if c.Tracing.Endpoint == "" {
+			errs = append(errs, errors.New("tracing.endpoint is required for the otlp exporter"))
+		}
+	case "file":
+		if c.Tracing.File == "" {
+			errs = append(errs, errors.New("tracing.file is required for the file exporter"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("tracing.exporter must be none, otlp, stdout or file, got %q", c.Tracing.Exporter))
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, errors.New("tracing.sample_ratio must be between 0 and 1"))
+	}
+	switch c.Log.Level {
+	case "debug", "info", "warn", "error":
+	default:
+		errs = append(errs, fmt.Errorf("log.level must be debug, info, warn or error, got %q", c.Log.Level))
+	}
+	if c.Log.Format != "json" && c.Log.Format != "text" {
+		errs = append(errs, fmt.Errorf("log.format must be json or text, got %q", c.Log.Format))
+	}
+	return errors.Join(errs...)
+}
+
+// Print выводит конфигурацию в YAML, пароли скрыты
+func (c *Config) Print(w io.Writer) error {
+	redacted := *c
+	redacted.Redis.Password = configload.Redact(c.Redis.Password)
+	redacted.Redis.SentinelPassword = configload.Redact(c.Redis.SentinelPassword)
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&redacted); err != nil {
+		return err
+	}
+	return enc.Close()
+}
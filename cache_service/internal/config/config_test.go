@@ -0,0 +1,98 @@
+package config
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func env(vars map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		v, ok := vars[key]
+		return v, ok
+	}
+}
+
+func TestLoadDefaults(t *testing.T) {
+	cfg, err := load(nil, env(nil), io.Discard)
+	require.NoError(t, err)
+	assert.Equal(t, *Default(), *cfg)
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+server:
+  grpc_addr: ":6000"
+redis:
+  addr: "file-redis:6379"
+  password: ""
+  db: 1
+`), 0o600))
+
+	cfg, err := load(
+		[]string{"--config", path, "--redis-db", "3"},
+		env(map[string]string{"REDIS_ADDR": "env-redis:6379", "REDIS_DB": "2"}),
+		io.Discard,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, ":6000", cfg.Server.GRPCAddr)
+	assert.Equal(t, ":8052", cfg.Server.MetricsAddr)
+	assert.Equal(t, "env-redis:6379", cfg.Redis.Addr)
+	assert.Equal(t, "", cfg.Redis.Password)
+	assert.Equal(t, 3, cfg.Redis.DB)
+}
+
+func TestLoadErrors(t *testing.T) {
+	_, err := load(nil, env(map[string]string{"REDIS_DB": "first"}), io.Discard)
+	assert.ErrorContains(t, err, "REDIS_DB")
+
+	_, err = load([]string{"--redis-addr", "", "--redis-db", "-1"}, env(nil), io.Discard)
+	assert.ErrorContains(t, err, "redis.addr is required")
+	assert.ErrorContains(t, err, "redis.db must not be negative")
+
+	_, err = load(nil, env(map[string]string{"SESSION_IDLE_TIMEOUT": "48h"}), io.Discard)
+	assert.ErrorContains(t, err, "session.max_lifetime must not be less than")
+}
+
+func TestRedisModes(t *testing.T) {
+	cfg, err := load(
+		[]string{"--redis-mode", "sentinel", "--redis-master-name", "mymaster", "--redis-tls"},
+		env(map[string]string{"REDIS_ADDR": "s1:26379, s2:26379,s3:26379"}),
+		io.Discard,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"s1:26379", "s2:26379", "s3:26379"}, cfg.Redis.Addrs())
+	assert.True(t, cfg.Redis.TLS.Enabled)
+
+	_, err = load([]string{"--redis-mode", "sentinel", "--redis-addr", "a:1,b:2"}, env(nil), io.Discard)
+	assert.ErrorContains(t, err, "redis.master_name is required")
+
+	_, err = load([]string{"--redis-mode", "cluster", "--redis-db", "1"}, env(nil), io.Discard)
+	assert.ErrorContains(t, err, "redis.db must be 0 in cluster mode")
+
+	_, err = load([]string{"--redis-addr", "a:1,b:2", "--redis-tls-cert-file", "client.pem"}, env(nil), io.Discard)
+	assert.ErrorContains(t, err, "redis.addr must be a single address")
+	assert.ErrorContains(t, err, "must be set together")
+	assert.ErrorContains(t, err, "redis.tls.enabled is required")
+
+	_, err = load([]string{"--redis-mode", "replica"}, env(nil), io.Discard)
+	assert.ErrorContains(t, err, "redis.mode must be standalone, sentinel or cluster")
+}
+
+func TestPrintRedactsPassword(t *testing.T) {
+	cfg, err := load([]string{"--print-config", "--redis-sentinel-password", "sentinel-secret"}, env(nil), io.Discard)
+	require.NoError(t, err)
+	assert.True(t, cfg.PrintOnly)
+
+	var buf bytes.Buffer
+	require.NoError(t, cfg.Print(&buf))
+	assert.NotContains(t, buf.String(), "admin")
+	assert.NotContains(t, buf.String(), "sentinel-secret")
+	assert.Contains(t, buf.String(), "addr: cache:6379")
+}
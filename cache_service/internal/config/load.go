@@ -0,0 +1,71 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"platform/configload"
+)
+
+// fields перечисляет все настраиваемые поля
+func (c *Config) fields() []configload.Field {
+	return []configload.Field{
+		{Flag: "grpc-addr", Env: "GRPC_ADDR", Usage: "CacheService gRPC listen address", Value: (*configload.String)(&c.Server.GRPCAddr)},
+		{Flag: "metrics-addr", Env: "METRICS_ADDR", Usage: "metrics and health server address", Value: (*configload.String)(&c.Server.MetricsAddr)},
+		{Flag: "shutdown-timeout", Env: "SHUTDOWN_TIMEOUT", Usage: "limit for GracefulStop before connections are closed", Value: (*configload.Duration)(&c.Server.ShutdownTimeout)},
+		{Flag: "redis-mode", Env: "REDIS_MODE", Usage: "Redis topology: standalone, sentinel or cluster", Value: (*configload.String)(&c.Redis.Mode)},
+		{Flag: "redis-addr", Env: "REDIS_ADDR", Usage: "Redis address; comma-separated sentinels or cluster seed nodes", Value: (*configload.String)(&c.Redis.Addr)},
+		{Flag: "redis-master-name", Env: "REDIS_MASTER_NAME", Usage: "master name watched by the sentinels", Value: (*configload.String)(&c.Redis.MasterName)},
+		{Flag: "redis-username", Env: "REDIS_USERNAME", Usage: "Redis ACL username", Value: (*configload.String)(&c.Redis.Username)},
+		{Flag: "redis-password", Env: "REDIS_PASSWORD", Usage: "Redis password", Value: (*configload.String)(&c.Redis.Password)},
+		{Flag: "redis-sentinel-username", Env: "REDIS_SENTINEL_USERNAME", Usage: "Sentinel ACL username", Value: (*configload.String)(&c.Redis.SentinelUsername)},
+		{Flag: "redis-sentinel-password", Env: "REDIS_SENTINEL_PASSWORD", Usage: "Sentinel password", Value: (*configload.String)(&c.Redis.SentinelPassword)},
+		{Flag: "redis-db", Env: "REDIS_DB", Usage: "Redis database number", Value: (*configload.Int)(&c.Redis.DB)},
+		{Flag: "redis-tls", Env: "REDIS_TLS", Usage: "connect to Redis over TLS", Value: (*configload.Bool)(&c.Redis.TLS.Enabled)},
+		{Flag: "redis-tls-ca-file", Env: "REDIS_TLS_CA_FILE", Usage: "CA bundle for the Redis server certificate", Value: (*configload.String)(&c.Redis.TLS.CAFile)},
+		{Flag: "redis-tls-cert-file", Env: "REDIS_TLS_CERT_FILE", Usage: "client certificate for Redis mTLS", Value: (*configload.String)(&c.Redis.TLS.CertFile)},
+		{Flag: "redis-tls-key-file", Env: "REDIS_TLS_KEY_FILE", Usage: "client key for Redis mTLS", Value: (*configload.String)(&c.Redis.TLS.KeyFile)},
+		{Flag: "redis-tls-server-name", Env: "REDIS_TLS_SERVER_NAME", Usage: "server name to verify the Redis certificate against", Value: (*configload.String)(&c.Redis.TLS.ServerName)},
+		{Flag: "redis-backoff-base", Env: "REDIS_BACKOFF_BASE", Usage: "initial delay between Redis connection attempts", Value: (*configload.Duration)(&c.Redis.BackoffBase)},
+		{Flag: "redis-backoff-max", Env: "REDIS_BACKOFF_MAX", Usage: "maximum delay between Redis connection attempts", Value: (*configload.Duration)(&c.Redis.BackoffMax)},
+		{Flag: "health-timeout", Env: "HEALTH_TIMEOUT", Usage: "timeout for the Redis check on /readyz", Value: (*configload.Duration)(&c.Health.Timeout)},
+		{Flag: "health-cache-ttl", Env: "HEALTH_CACHE_TTL", Usage: "how long a /readyz result is reused", Value: (*configload.Duration)(&c.Health.CacheTTL)},
+		{Flag: "health-interval", Env: "HEALTH_INTERVAL", Usage: "how often Redis is pinged for grpc.health.v1", Value: (*configload.Duration)(&c.Health.Interval)},
+		{Flag: "tracing-exporter", Env: "TRACING_EXPORTER", Usage: "span exporter: none, otlp, stdout or file", Value: (*configload.String)(&c.Tracing.Exporter)},
+		{Flag: "tracing-endpoint", Env: "TRACING_ENDPOINT", Usage: "OTLP gRPC collector address", Value: (*configload.String)(&c.Tracing.Endpoint)},
+		{Flag: "tracing-file", Env: "TRACING_FILE", Usage: "file for the file exporter", Value: (*configload.String)(&c.Tracing.File)},
+		{Flag: "tracing-sample-ratio", Env: "TRACING_SAMPLE_RATIO", Usage: "fraction of new traces to record", Value: (*configload.Float)(&c.Tracing.SampleRatio)},
+		{Flag: "log-level", Env: "LOG_LEVEL", Usage: "log level: debug, info, warn or error", Value: (*configload.String)(&c.Log.Level)},
+		{Flag: "log-format", Env: "LOG_FORMAT", Usage: "log format: json or text", Value: (*configload.String)(&c.Log.Format)},
+		{Flag: "login-window", Env: "LOGIN_WINDOW", Usage: "window in which failed logins are counted", Value: (*configload.Duration)(&c.Login.Window)},
+		{Flag: "login-max-user-failures", Env: "LOGIN_MAX_USER_FAILURES", Usage: "failed logins per username before lockout", Value: (*configload.Int)(&c.Login.MaxUserFailures)},
+		{Flag: "login-max-ip-failures", Env: "LOGIN_MAX_IP_FAILURES", Usage: "failed logins per IP before lockout", Value: (*configload.Int)(&c.Login.MaxIPFailures)},
+		{Flag: "login-lockout-base", Env: "LOGIN_LOCKOUT_BASE", Usage: "first lockout duration, doubled on each next lockout", Value: (*configload.Duration)(&c.Login.LockoutBase)},
+		{Flag: "login-lockout-max", Env: "LOGIN_LOCKOUT_MAX", Usage: "maximum lockout duration", Value: (*configload.Duration)(&c.Login.LockoutMax)},
+		{Flag: "session-ttl", Env: "SESSION_TTL", Usage: "session lifetime when the writer does not set one", Value: (*configload.Duration)(&c.Session.TTL)},
+		{Flag: "session-idle-timeout", Env: "SESSION_IDLE_TIMEOUT", Usage: "extend sessions by this much on every access, 0 disables sliding expiry", Value: (*configload.Duration)(&c.Session.IdleTimeout)},
+		{Flag: "session-max-lifetime", Env: "SESSION_MAX_LIFETIME", Usage: "absolute session lifetime that sliding expiry never exceeds", Value: (*configload.Duration)(&c.Session.MaxLifetime)},
+	}
+}
+
+// Load читает конфигурацию: значения по умолчанию, затем YAML файл (--config или
+// CONFIG_FILE), затем переменные окружения, затем флаги из args
+func Load(args []string) (*Config, error) {
+	return load(args, os.LookupEnv, os.Stderr)
+}
+
+func load(args []string, lookupEnv func(string) (string, bool), output io.Writer) (*Config, error) {
+	cfg, printOnly, err := configload.Load("cache_service", args, lookupEnv, output, func() (*Config, []configload.Field) {
+		c := Default()
+		return c, c.fields()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.PrintOnly = printOnly
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	return cfg, nil
+}
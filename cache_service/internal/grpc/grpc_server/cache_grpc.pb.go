@@ -19,21 +19,51 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	CacheService_Write_FullMethodName      = "/cache_service.CacheService/Write"
-	CacheService_GetUser_FullMethodName    = "/cache_service.CacheService/GetUser"
-	CacheService_DeleteUser_FullMethodName = "/cache_service.CacheService/DeleteUser"
+	CacheService_Write_FullMethodName              = "/cache_service.CacheService/Write"
+	CacheService_GetUser_FullMethodName            = "/cache_service.CacheService/GetUser"
+	CacheService_DeleteUser_FullMethodName         = "/cache_service.CacheService/DeleteUser"
+	CacheService_DeleteUserSessions_FullMethodName = "/cache_service.CacheService/DeleteUserSessions"
+	CacheService_WriteRefreshToken_FullMethodName  = "/cache_service.CacheService/WriteRefreshToken"
+	CacheService_RotateRefreshToken_FullMethodName = "/cache_service.CacheService/RotateRefreshToken"
+	CacheService_RateLimit_FullMethodName          = "/cache_service.CacheService/RateLimit"
+	CacheService_CheckLogin_FullMethodName         = "/cache_service.CacheService/CheckLogin"
+	CacheService_LoginFailed_FullMethodName        = "/cache_service.CacheService/LoginFailed"
+	CacheService_LoginSucceeded_FullMethodName     = "/cache_service.CacheService/LoginSucceeded"
+	CacheService_ClearLoginLock_FullMethodName     = "/cache_service.CacheService/ClearLoginLock"
+	CacheService_ListSessions_FullMethodName       = "/cache_service.CacheService/ListSessions"
+	CacheService_RevokeSession_FullMethodName      = "/cache_service.CacheService/RevokeSession"
 )
 
 // CacheServiceClient is the client API for CacheService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CacheServiceClient interface {
-	// Write jwt key to cache
+	// Write jwt key to cache, writing the same key again only refreshes the session
 	Write(ctx context.Context, in *WriteRequest, opts ...grpc.CallOption) (*WriteResponse, error)
-	// Get user_id & login from cache if exists
+	// Get user_id & login from cache if exists, extend the session if sliding expiry is on
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	// Delete user from cache
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	// Delete every jwt key of the user from cache
+	DeleteUserSessions(ctx context.Context, in *DeleteUserSessionsRequest, opts ...grpc.CallOption) (*DeleteUserSessionsResponse, error)
+	// Write refresh token which starts a new token family
+	WriteRefreshToken(ctx context.Context, in *WriteRefreshTokenRequest, opts ...grpc.CallOption) (*WriteRefreshTokenResponse, error)
+	// Exchange refresh token for a new one, revoke the whole family on reuse
+	RotateRefreshToken(ctx context.Context, in *RotateRefreshTokenRequest, opts ...grpc.CallOption) (*RotateRefreshTokenResponse, error)
+	// Take tokens from a token bucket shared by all api_service replicas
+	RateLimit(ctx context.Context, in *RateLimitRequest, opts ...grpc.CallOption) (*RateLimitResponse, error)
+	// Check whether logins for the username or from the ip are locked
+	CheckLogin(ctx context.Context, in *LoginAttemptRequest, opts ...grpc.CallOption) (*LoginAttemptResponse, error)
+	// Count a failed login, lock the username or ip after too many failures
+	LoginFailed(ctx context.Context, in *LoginAttemptRequest, opts ...grpc.CallOption) (*LoginAttemptResponse, error)
+	// Reset failed logins of the username after a successful login
+	LoginSucceeded(ctx context.Context, in *LoginAttemptRequest, opts ...grpc.CallOption) (*LoginAttemptResponse, error)
+	// Remove the lock and failed logins of the username and/or ip
+	ClearLoginLock(ctx context.Context, in *ClearLoginLockRequest, opts ...grpc.CallOption) (*ClearLoginLockResponse, error)
+	// List active sessions (devices) of the user, most recently used first
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	// Delete one session of the user by its id
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
 }
 
 type cacheServiceClient struct {
@@ -74,16 +104,136 @@ func (c *cacheServiceClient) DeleteUser(ctx context.Context, in *DeleteUserReque
 	return out, nil
 }
 
+func (c *cacheServiceClient) DeleteUserSessions(ctx context.Context, in *DeleteUserSessionsRequest, opts ...grpc.CallOption) (*DeleteUserSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserSessionsResponse)
+	err := c.cc.Invoke(ctx, CacheService_DeleteUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) WriteRefreshToken(ctx context.Context, in *WriteRefreshTokenRequest, opts ...grpc.CallOption) (*WriteRefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteRefreshTokenResponse)
+	err := c.cc.Invoke(ctx, CacheService_WriteRefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) RotateRefreshToken(ctx context.Context, in *RotateRefreshTokenRequest, opts ...grpc.CallOption) (*RotateRefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateRefreshTokenResponse)
+	err := c.cc.Invoke(ctx, CacheService_RotateRefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) RateLimit(ctx context.Context, in *RateLimitRequest, opts ...grpc.CallOption) (*RateLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RateLimitResponse)
+	err := c.cc.Invoke(ctx, CacheService_RateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CheckLogin(ctx context.Context, in *LoginAttemptRequest, opts ...grpc.CallOption) (*LoginAttemptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginAttemptResponse)
+	err := c.cc.Invoke(ctx, CacheService_CheckLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) LoginFailed(ctx context.Context, in *LoginAttemptRequest, opts ...grpc.CallOption) (*LoginAttemptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginAttemptResponse)
+	err := c.cc.Invoke(ctx, CacheService_LoginFailed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) LoginSucceeded(ctx context.Context, in *LoginAttemptRequest, opts ...grpc.CallOption) (*LoginAttemptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginAttemptResponse)
+	err := c.cc.Invoke(ctx, CacheService_LoginSucceeded_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) ClearLoginLock(ctx context.Context, in *ClearLoginLockRequest, opts ...grpc.CallOption) (*ClearLoginLockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearLoginLockResponse)
+	err := c.cc.Invoke(ctx, CacheService_ClearLoginLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, CacheService_ListSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, CacheService_RevokeSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CacheServiceServer is the server API for CacheService service.
 // All implementations must embed UnimplementedCacheServiceServer
 // for forward compatibility
 type CacheServiceServer interface {
-	// Write jwt key to cache
+	// Write jwt key to cache, writing the same key again only refreshes the session
 	Write(context.Context, *WriteRequest) (*WriteResponse, error)
-	// Get user_id & login from cache if exists
+	// Get user_id & login from cache if exists, extend the session if sliding expiry is on
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	// Delete user from cache
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// Delete every jwt key of the user from cache
+	DeleteUserSessions(context.Context, *DeleteUserSessionsRequest) (*DeleteUserSessionsResponse, error)
+	// Write refresh token which starts a new token family
+	WriteRefreshToken(context.Context, *WriteRefreshTokenRequest) (*WriteRefreshTokenResponse, error)
+	// Exchange refresh token for a new one, revoke the whole family on reuse
+	RotateRefreshToken(context.Context, *RotateRefreshTokenRequest) (*RotateRefreshTokenResponse, error)
+	// Take tokens from a token bucket shared by all api_service replicas
+	RateLimit(context.Context, *RateLimitRequest) (*RateLimitResponse, error)
+	// Check whether logins for the username or from the ip are locked
+	CheckLogin(context.Context, *LoginAttemptRequest) (*LoginAttemptResponse, error)
+	// Count a failed login, lock the username or ip after too many failures
+	LoginFailed(context.Context, *LoginAttemptRequest) (*LoginAttemptResponse, error)
+	// Reset failed logins of the username after a successful login
+	LoginSucceeded(context.Context, *LoginAttemptRequest) (*LoginAttemptResponse, error)
+	// Remove the lock and failed logins of the username and/or ip
+	ClearLoginLock(context.Context, *ClearLoginLockRequest) (*ClearLoginLockResponse, error)
+	// List active sessions (devices) of the user, most recently used first
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	// Delete one session of the user by its id
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
 	mustEmbedUnimplementedCacheServiceServer()
 }
 
@@ -100,6 +250,36 @@ func (UnimplementedCacheServiceServer) GetUser(context.Context, *GetUserRequest)
 func (UnimplementedCacheServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedCacheServiceServer) DeleteUserSessions(context.Context, *DeleteUserSessionsRequest) (*DeleteUserSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserSessions not implemented")
+}
+func (UnimplementedCacheServiceServer) WriteRefreshToken(context.Context, *WriteRefreshTokenRequest) (*WriteRefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteRefreshToken not implemented")
+}
+func (UnimplementedCacheServiceServer) RotateRefreshToken(context.Context, *RotateRefreshTokenRequest) (*RotateRefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateRefreshToken not implemented")
+}
+func (UnimplementedCacheServiceServer) RateLimit(context.Context, *RateLimitRequest) (*RateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimit not implemented")
+}
+func (UnimplementedCacheServiceServer) CheckLogin(context.Context, *LoginAttemptRequest) (*LoginAttemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckLogin not implemented")
+}
+func (UnimplementedCacheServiceServer) LoginFailed(context.Context, *LoginAttemptRequest) (*LoginAttemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginFailed not implemented")
+}
+func (UnimplementedCacheServiceServer) LoginSucceeded(context.Context, *LoginAttemptRequest) (*LoginAttemptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginSucceeded not implemented")
+}
+func (UnimplementedCacheServiceServer) ClearLoginLock(context.Context, *ClearLoginLockRequest) (*ClearLoginLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearLoginLock not implemented")
+}
+func (UnimplementedCacheServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedCacheServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
 func (UnimplementedCacheServiceServer) mustEmbedUnimplementedCacheServiceServer() {}
 
 // UnsafeCacheServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -167,6 +347,186 @@ func _CacheService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CacheService_DeleteUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_DeleteUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteUserSessions(ctx, req.(*DeleteUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_WriteRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteRefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).WriteRefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_WriteRefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).WriteRefreshToken(ctx, req.(*WriteRefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_RotateRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateRefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).RotateRefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_RotateRefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).RotateRefreshToken(ctx, req.(*RotateRefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_RateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).RateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_RateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).RateLimit(ctx, req.(*RateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CheckLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CheckLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_CheckLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CheckLogin(ctx, req.(*LoginAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_LoginFailed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).LoginFailed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_LoginFailed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).LoginFailed(ctx, req.(*LoginAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_LoginSucceeded_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginAttemptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).LoginSucceeded(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_LoginSucceeded_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).LoginSucceeded(ctx, req.(*LoginAttemptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_ClearLoginLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearLoginLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).ClearLoginLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_ClearLoginLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).ClearLoginLock(ctx, req.(*ClearLoginLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CacheService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CacheService_ServiceDesc is the grpc.ServiceDesc for CacheService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -186,6 +546,46 @@ var CacheService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _CacheService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "DeleteUserSessions",
+			Handler:    _CacheService_DeleteUserSessions_Handler,
+		},
+		{
+			MethodName: "WriteRefreshToken",
+			Handler:    _CacheService_WriteRefreshToken_Handler,
+		},
+		{
+			MethodName: "RotateRefreshToken",
+			Handler:    _CacheService_RotateRefreshToken_Handler,
+		},
+		{
+			MethodName: "RateLimit",
+			Handler:    _CacheService_RateLimit_Handler,
+		},
+		{
+			MethodName: "CheckLogin",
+			Handler:    _CacheService_CheckLogin_Handler,
+		},
+		{
+			MethodName: "LoginFailed",
+			Handler:    _CacheService_LoginFailed_Handler,
+		},
+		{
+			MethodName: "LoginSucceeded",
+			Handler:    _CacheService_LoginSucceeded_Handler,
+		},
+		{
+			MethodName: "ClearLoginLock",
+			Handler:    _CacheService_ClearLoginLock_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _CacheService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _CacheService_RevokeSession_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/cache.proto",
@@ -3,77 +3,324 @@ package grpcclient
 import (
 	"cache_service/internal/cache"
 	"cache_service/internal/grpc/grpc_server"
+	"cache_service/internal/metrics"
 	"context"
-	"fmt"
+	"errors"
 	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type CacheServiceServer struct {
 	grpc_server.UnimplementedCacheServiceServer
 	Cch *cache.Cache
+	// Login - пороги блокировки входа
+	Login cache.LoginPolicy
+	// Sessions - сроки жизни сессий
+	Sessions cache.SessionPolicy
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
 }
 
 func (c *CacheServiceServer) DeleteUser(ctx context.Context, req *grpc_server.DeleteUserRequest) (
 	*grpc_server.DeleteUserResponse, error,
 ) {
-	if c.Cch.Exists(ctx, req.JwtKey) {
-		err := c.Cch.Delete(ctx, req.JwtKey)
-		if err != nil {
-			return &grpc_server.DeleteUserResponse{
-				Success: false,
-			}, err
-		}
+	// Наличие сессии видно по ответу DEL: проверка перед удалением гонялась бы с ним
+	err := c.Cch.Delete(ctx, req.JwtKey)
+	if errors.Is(err, cache.ErrSessionNotFound) {
+		metrics.Miss("DeleteUser")
 		return &grpc_server.DeleteUserResponse{
-			Success: true,
-		}, nil
-	} else {
+			Success: false,
+		}, status.Error(codes.NotFound, "Cache doesn't exists")
+	}
+	if err != nil {
 		return &grpc_server.DeleteUserResponse{
 			Success: false,
-		}, fmt.Errorf("Cache doesn't exists")
+		}, status.Error(codes.Unavailable, "Error in redis delete")
+	}
+	metrics.Hit("DeleteUser")
+	return &grpc_server.DeleteUserResponse{
+		Success: true,
+	}, nil
+}
+
+func (c *CacheServiceServer) DeleteUserSessions(ctx context.Context, req *grpc_server.DeleteUserSessionsRequest) (
+	*grpc_server.DeleteUserSessionsResponse, error,
+) {
+	deleted, err := c.Cch.DeleteUser(ctx, req.UserId)
+	if err != nil {
+		return &grpc_server.DeleteUserSessionsResponse{
+			Success: false,
+		}, status.Error(codes.Unavailable, "Error in redis delete")
 	}
+	return &grpc_server.DeleteUserSessionsResponse{
+		Success: true,
+		Deleted: int32(deleted),
+	}, nil
 }
 
 func (c *CacheServiceServer) GetUser(ctx context.Context, req *grpc_server.GetUserRequest) (
 	*grpc_server.GetUserResponse, error,
 ) {
-	if c.Cch.Exists(ctx, req.JwtKey) {
-		id, login, err := c.Cch.GetData(ctx, req.JwtKey)
-		if err != nil {
-			return &grpc_server.GetUserResponse{
-				Success:   false,
-				UserId:    0,
-				UserLogin: "",
-			}, err
-		}
+	// Отсутствие сессии видно по пустому HGETALL, отдельный EXISTS не нужен
+	s, err := c.Cch.GetSession(ctx, req.JwtKey)
+	if err != nil && !errors.Is(err, cache.ErrSessionNotFound) {
 		return &grpc_server.GetUserResponse{
-			Success:   true,
-			UserLogin: login,
-			UserId:    int32(id),
-		}, nil
+			Success:   false,
+			UserId:    0,
+			UserLogin: "",
+		}, status.Error(codes.Unavailable, "Error in redis get")
+	}
+	var expiresAt time.Time
+	if err == nil {
+		expiresAt, err = c.Cch.Touch(ctx, req.JwtKey, c.Sessions.IdleTimeout)
+	}
+	// Сессия могла истечь между чтением и продлением
+	if errors.Is(err, cache.ErrSessionNotFound) {
+		metrics.Miss("GetUser")
+		return &grpc_server.GetUserResponse{
+			Success:   false,
+			UserId:    0,
+			UserLogin: "",
+		}, status.Error(codes.NotFound, "Doesn't exist")
 	}
+	metrics.Hit("GetUser")
+	// Ошибка продления не мешает авторизации, срок тогда просто неизвестен
 	return &grpc_server.GetUserResponse{
-		Success:   false,
-		UserId:    0,
-		UserLogin: "",
-	}, fmt.Errorf("Doesn't exist")
+		Success:      true,
+		UserLogin:    s.Login,
+		UserId:       s.UserID,
+		SessionId:    s.ID,
+		ExpiresAt:    unixOrZero(expiresAt),
+		MaxExpiresAt: unixOrZero(s.Deadline),
+	}, nil
 }
 
 func (c *CacheServiceServer) Write(ctx context.Context, req *grpc_server.WriteRequest) (
 	*grpc_server.WriteResponse, error,
 ) {
-	if c.Cch.Exists(ctx, req.JwtKey) {
+	if req.JwtKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "jwt_key is required")
+	}
+	ttl := c.Sessions.TTL
+	if req.TtlSeconds != nil && *req.TtlSeconds > 0 {
+		ttl = time.Duration(*req.TtlSeconds) * time.Second
+	}
+	s, created, err := c.Cch.SaveSession(ctx, req.JwtKey, cache.Session{
+		UserID:    req.UserId,
+		Login:     req.UserLogin,
+		UserAgent: req.GetUserAgent(),
+		IP:        req.GetIp(),
+	}, ttl, c.Sessions)
+	if errors.Is(err, cache.ErrSessionExpired) {
 		return &grpc_server.WriteResponse{
 			Success: false,
-		}, fmt.Errorf("Is already in redis")
+		}, status.Error(codes.FailedPrecondition, "Session lifetime is over")
+	}
+	if errors.Is(err, cache.ErrSessionConflict) {
+		metrics.Hit("Write")
+		return &grpc_server.WriteResponse{
+			Success: false,
+		}, status.Error(codes.AlreadyExists, "Is already in redis")
 	}
-	err := c.Cch.SetData(ctx, req.JwtKey, req.UserLogin, req.UserId)
 	if err != nil {
 		return &grpc_server.WriteResponse{
 			Success: false,
-		}, fmt.Errorf("Error in redis set")
+		}, status.Error(codes.Unavailable, "Error in redis set")
+	}
+	// Повторная запись того же токена - не ошибка, сессия только продлевается
+	if created {
+		metrics.Miss("Write")
+	} else {
+		metrics.Hit("Write")
+	}
+	if req.FamilyId != nil {
+		if err := c.Cch.AddToFamily(ctx, *req.FamilyId, req.JwtKey, ttl); err != nil {
+			return &grpc_server.WriteResponse{
+				Success: false,
+			}, status.Error(codes.Unavailable, "Error in redis set")
+		}
 	}
-	_ = c.Cch.SetDeadTime(ctx, req.JwtKey, time.Hour*24)
 	return &grpc_server.WriteResponse{
+		Success:      true,
+		SessionId:    s.ID,
+		ExpiresAt:    unixOrZero(s.ExpiresAt),
+		MaxExpiresAt: unixOrZero(s.Deadline),
+	}, nil
+}
+
+func (c *CacheServiceServer) WriteRefreshToken(ctx context.Context, req *grpc_server.WriteRefreshTokenRequest) (
+	*grpc_server.WriteRefreshTokenResponse, error,
+) {
+	if req.TtlSeconds <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ttl_seconds must be positive")
+	}
+	err := c.Cch.SetRefreshToken(ctx, req.RefreshToken, cache.RefreshToken{
+		UserID: req.UserId,
+		Login:  req.UserLogin,
+		Family: req.FamilyId,
+	}, time.Duration(req.TtlSeconds)*time.Second)
+	if err != nil {
+		return &grpc_server.WriteRefreshTokenResponse{
+			Success: false,
+		}, status.Error(codes.Unavailable, "Error in redis set")
+	}
+	return &grpc_server.WriteRefreshTokenResponse{
+		Success: true,
+	}, nil
+}
+
+func (c *CacheServiceServer) RotateRefreshToken(ctx context.Context, req *grpc_server.RotateRefreshTokenRequest) (
+	*grpc_server.RotateRefreshTokenResponse, error,
+) {
+	if req.TtlSeconds <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ttl_seconds must be positive")
+	}
+	rt, err := c.Cch.RotateRefreshToken(ctx, req.RefreshToken, req.NewRefreshToken,
+		time.Duration(req.TtlSeconds)*time.Second)
+	switch {
+	case errors.Is(err, cache.ErrRefreshReused):
+		metrics.Hit("RotateRefreshToken")
+		msg := err.Error()
+		return &grpc_server.RotateRefreshTokenResponse{
+			Success: false,
+			Reused:  true,
+			Error:   &msg,
+		}, nil
+	case errors.Is(err, cache.ErrRefreshNotFound):
+		metrics.Miss("RotateRefreshToken")
+		msg := err.Error()
+		return &grpc_server.RotateRefreshTokenResponse{
+			Success: false,
+			Error:   &msg,
+		}, nil
+	case err != nil:
+		return &grpc_server.RotateRefreshTokenResponse{
+			Success: false,
+		}, status.Error(codes.Unavailable, "Error in redis rotate")
+	}
+	metrics.Hit("RotateRefreshToken")
+	return &grpc_server.RotateRefreshTokenResponse{
+		Success:   true,
+		UserId:    rt.UserID,
+		UserLogin: rt.Login,
+		FamilyId:  rt.Family,
+	}, nil
+}
+
+func (c *CacheServiceServer) RateLimit(ctx context.Context, req *grpc_server.RateLimitRequest) (
+	*grpc_server.RateLimitResponse, error,
+) {
+	if req.Key == "" || req.Capacity <= 0 || req.RefillRate <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "key, capacity and refill_rate are required")
+	}
+	d, err := c.Cch.TakeToken(ctx, req.Key, cache.Limit{
+		Capacity: int(req.Capacity),
+		Rate:     req.RefillRate,
+	}, int(req.Cost))
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "Error in redis rate limit")
+	}
+	return &grpc_server.RateLimitResponse{
+		Allowed:      d.Allowed,
+		Remaining:    int32(d.Remaining),
+		RetryAfterMs: d.RetryAfter.Milliseconds(),
+		ResetAfterMs: d.ResetAfter.Milliseconds(),
+	}, nil
+}
+
+func loginAttemptResponse(st cache.LoginStatus) *grpc_server.LoginAttemptResponse {
+	return &grpc_server.LoginAttemptResponse{
+		Locked:       st.Locked,
+		RetryAfterMs: st.RetryAfter.Milliseconds(),
+		UserFailures: int32(st.UserFailures),
+		IpFailures:   int32(st.IPFailures),
+	}
+}
+
+func (c *CacheServiceServer) CheckLogin(ctx context.Context, req *grpc_server.LoginAttemptRequest) (
+	*grpc_server.LoginAttemptResponse, error,
+) {
+	st, err := c.Cch.LoginStatus(ctx, req.Username, req.Ip, c.Login.Window)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "Error in redis login check")
+	}
+	return loginAttemptResponse(st), nil
+}
+
+func (c *CacheServiceServer) LoginFailed(ctx context.Context, req *grpc_server.LoginAttemptRequest) (
+	*grpc_server.LoginAttemptResponse, error,
+) {
+	st, err := c.Cch.LoginFailed(ctx, req.Username, req.Ip, c.Login)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "Error in redis login failure")
+	}
+	return loginAttemptResponse(st), nil
+}
+
+func (c *CacheServiceServer) LoginSucceeded(ctx context.Context, req *grpc_server.LoginAttemptRequest) (
+	*grpc_server.LoginAttemptResponse, error,
+) {
+	if err := c.Cch.LoginSucceeded(ctx, req.Username); err != nil {
+		return nil, status.Error(codes.Unavailable, "Error in redis delete")
+	}
+	return &grpc_server.LoginAttemptResponse{}, nil
+}
+
+func (c *CacheServiceServer) ClearLoginLock(ctx context.Context, req *grpc_server.ClearLoginLockRequest) (
+	*grpc_server.ClearLoginLockResponse, error,
+) {
+	if req.Username == "" && req.Ip == "" {
+		return nil, status.Error(codes.InvalidArgument, "username or ip is required")
+	}
+	cleared, err := c.Cch.ClearLoginLock(ctx, req.Username, req.Ip)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "Error in redis delete")
+	}
+	return &grpc_server.ClearLoginLockResponse{
+		Cleared: int32(cleared),
+	}, nil
+}
+
+func (c *CacheServiceServer) ListSessions(ctx context.Context, req *grpc_server.ListSessionsRequest) (
+	*grpc_server.ListSessionsResponse, error,
+) {
+	sessions, err := c.Cch.ListSessions(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "Error in redis get")
+	}
+	resp := &grpc_server.ListSessionsResponse{
+		Sessions: make([]*grpc_server.SessionInfo, 0, len(sessions)),
+	}
+	for _, s := range sessions {
+		resp.Sessions = append(resp.Sessions, &grpc_server.SessionInfo{
+			SessionId: s.ID,
+			CreatedAt: s.CreatedAt.Unix(),
+			LastSeen:  s.LastSeen.Unix(),
+			UserAgent: s.UserAgent,
+			Ip:        s.IP,
+		})
+	}
+	return resp, nil
+}
+
+func (c *CacheServiceServer) RevokeSession(ctx context.Context, req *grpc_server.RevokeSessionRequest) (
+	*grpc_server.RevokeSessionResponse, error,
+) {
+	err := c.Cch.RevokeSession(ctx, req.UserId, req.SessionId)
+	if errors.Is(err, cache.ErrSessionNotFound) {
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "Error in redis delete")
+	}
+	return &grpc_server.RevokeSessionResponse{
 		Success: true,
 	}, nil
 }
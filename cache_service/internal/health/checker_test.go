@@ -0,0 +1,71 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func readyz(c *Checker) (int, Report) {
+	w := httptest.NewRecorder()
+	c.ReadyzHandler(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	var report Report
+	json.NewDecoder(w.Body).Decode(&report)
+	return w.Code, report
+}
+
+func TestReadyz(t *testing.T) {
+	var fail atomic.Bool
+	readiness := NewReadiness()
+	checker := NewChecker(readiness, time.Second, 0,
+		Check{Name: "ok", Check: func(ctx context.Context) error { return nil }},
+		Check{Name: "flaky", Check: func(ctx context.Context) error {
+			if fail.Load() {
+				return errors.New("connection refused")
+			}
+			return nil
+		}},
+	)
+
+	code, report := readyz(checker)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, StatusOK, report.Status)
+	assert.Len(t, report.Checks, 2)
+
+	fail.Store(true)
+	code, report = readyz(checker)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, StatusOK, report.Checks["ok"].Status)
+	assert.Equal(t, "connection refused", report.Checks["flaky"].Error)
+
+	fail.Store(false)
+	readiness.SetDraining()
+	code, report = readyz(checker)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "draining", report.Checks["shutdown"].Error)
+}
+
+func TestCheckerTimeoutAndCache(t *testing.T) {
+	var calls atomic.Int32
+	checker := NewChecker(NewReadiness(), 20*time.Millisecond, time.Minute,
+		Check{Name: "slow", Check: func(ctx context.Context) error {
+			calls.Add(1)
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+	)
+
+	report := checker.Run(context.Background())
+	assert.Equal(t, StatusFail, report.Status)
+	assert.Equal(t, context.DeadlineExceeded.Error(), report.Checks["slow"].Error)
+
+	checker.Run(context.Background())
+	assert.Equal(t, int32(1), calls.Load())
+}
@@ -0,0 +1,43 @@
+// Package health отдаёт состояние сервиса для проб: /health и /livez - процесс жив,
+// /ready - сервис не останавливается, /readyz - ещё и все зависимости доступны
+package health
+
+import (
+	"net/http"
+	"sync/atomic"
+)
+
+// Readiness - готовность принимать трафик. На время остановки сервис
+// переводится в draining, чтобы балансировщик успел убрать его из ротации.
+type Readiness struct {
+	draining atomic.Bool
+}
+
+func NewReadiness() *Readiness {
+	return &Readiness{}
+}
+
+// SetDraining помечает сервис как останавливающийся
+func (r *Readiness) SetDraining() {
+	r.draining.Store(true)
+}
+
+func (r *Readiness) Ready() bool {
+	return !r.draining.Load()
+}
+
+// HealthHandler всегда отвечает 200, пока процесс обслуживает запросы
+func (r *Readiness) HealthHandler(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("OK"))
+}
+
+// ReadyHandler отвечает 503 во время остановки
+func (r *Readiness) ReadyHandler(w http.ResponseWriter, _ *http.Request) {
+	if !r.Ready() {
+		http.Error(w, "Shutting down", http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("OK"))
+}
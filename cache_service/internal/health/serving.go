@@ -0,0 +1,74 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Serving - статус grpc.health.v1, который следует за периодической проверкой
+// зависимости. Пока проверка не прошла ни разу, сервисы NOT_SERVING.
+type Serving struct {
+	server   *grpchealth.Server
+	services []string
+	check    func(ctx context.Context) error
+	interval time.Duration
+	timeout  time.Duration
+}
+
+// NewServing создаёт health сервер для services; пустое имя ("") - статус всего сервера
+func NewServing(check func(ctx context.Context) error, interval, timeout time.Duration, services ...string) *Serving {
+	s := &Serving{
+		server:   grpchealth.NewServer(),
+		services: append([]string{""}, services...),
+		check:    check,
+		interval: interval,
+		timeout:  timeout,
+	}
+	s.set(healthpb.HealthCheckResponse_NOT_SERVING)
+	return s
+}
+
+// Register добавляет grpc.health.v1 на gRPC сервер
+func (s *Serving) Register(r grpc.ServiceRegistrar) {
+	healthpb.RegisterHealthServer(r, s.server)
+}
+
+// Run проверяет зависимость сразу и затем каждые interval, пока не отменён ctx
+func (s *Serving) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		s.update(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Serving) update(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	if err := s.check(ctx); err != nil {
+		s.set(healthpb.HealthCheckResponse_NOT_SERVING)
+		return
+	}
+	s.set(healthpb.HealthCheckResponse_SERVING)
+}
+
+func (s *Serving) set(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, service := range s.services {
+		s.server.SetServingStatus(service, status)
+	}
+}
+
+// Shutdown переводит все сервисы в NOT_SERVING навсегда: вызывается перед
+// GracefulStop, чтобы клиенты перестали выбирать этот узел
+func (s *Serving) Shutdown() {
+	s.server.Shutdown()
+}
@@ -0,0 +1,64 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func servingStatus(t *testing.T, s *Serving, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := s.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	assert.NoError(t, err)
+	return resp.GetStatus()
+}
+
+func TestServing(t *testing.T) {
+	var fail atomic.Bool
+	s := NewServing(func(ctx context.Context) error {
+		if fail.Load() {
+			return errors.New("redis down")
+		}
+		return nil
+	}, time.Hour, time.Second, "cache_service.CacheService")
+
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, s, ""))
+
+	s.update(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, s, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, s, "cache_service.CacheService"))
+
+	fail.Store(true)
+	s.update(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, s, "cache_service.CacheService"))
+
+	// После Shutdown успешная проверка не возвращает SERVING
+	fail.Store(false)
+	s.Shutdown()
+	s.update(context.Background())
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, s, ""))
+}
+
+func TestServingRun(t *testing.T) {
+	var calls atomic.Int32
+	s := NewServing(func(ctx context.Context) error {
+		calls.Add(1)
+		return nil
+	}, 10*time.Millisecond, time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+	assert.Eventually(t, func() bool { return calls.Load() >= 3 }, time.Second, 5*time.Millisecond)
+	cancel()
+	<-done
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, s, ""))
+}
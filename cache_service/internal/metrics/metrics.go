@@ -0,0 +1,108 @@
+// Package metrics - метрики Prometheus cache_service
+package metrics
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	cacheHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_hits_total",
+			Help: "Total cache hits",
+		},
+		[]string{"method"},
+	)
+
+	cacheMisses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cache_misses_total",
+			Help: "Total cache misses",
+		},
+		[]string{"method"},
+	)
+
+	cacheLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "cache_operation_duration_seconds",
+			Help:    "Cache operation duration",
+			Buckets: []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5},
+		},
+		[]string{"method", "result"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(cacheHits, cacheMisses, cacheLatency)
+}
+
+// Hit - ключ найден в Redis
+func Hit(method string) {
+	cacheHits.WithLabelValues(method).Inc()
+}
+
+// Miss - ключа в Redis нет
+func Miss(method string) {
+	cacheMisses.WithLabelValues(method).Inc()
+}
+
+// UnaryServerInterceptor измеряет длительность RPC; result - код gRPC ответа
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		cacheLatency.WithLabelValues(path.Base(info.FullMethod), status.Code(err).String()).
+			Observe(time.Since(start).Seconds())
+		return resp, err
+	}
+}
+
+// PoolStatser - источник статистики пула соединений Redis
+type PoolStatser interface {
+	PoolStats() *redis.PoolStats
+}
+
+var (
+	poolHitsDesc     = prometheus.NewDesc("redis_pool_hits_total", "Times a free connection was found in the pool", nil, nil)
+	poolMissesDesc   = prometheus.NewDesc("redis_pool_misses_total", "Times a free connection was not found in the pool", nil, nil)
+	poolTimeoutsDesc = prometheus.NewDesc("redis_pool_timeouts_total", "Times a wait for a connection timed out", nil, nil)
+	poolTotalDesc    = prometheus.NewDesc("redis_pool_total_conns", "Connections in the pool", nil, nil)
+	poolIdleDesc     = prometheus.NewDesc("redis_pool_idle_conns", "Idle connections in the pool", nil, nil)
+	poolStaleDesc    = prometheus.NewDesc("redis_pool_stale_conns_total", "Stale connections removed from the pool", nil, nil)
+)
+
+// poolCollector читает PoolStats при каждом scrape
+type poolCollector struct {
+	pool PoolStatser
+}
+
+// NewPoolCollector - коллектор статистики пула Redis, регистрируется в main
+func NewPoolCollector(pool PoolStatser) prometheus.Collector {
+	return &poolCollector{pool: pool}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- poolHitsDesc
+	ch <- poolMissesDesc
+	ch <- poolTimeoutsDesc
+	ch <- poolTotalDesc
+	ch <- poolIdleDesc
+	ch <- poolStaleDesc
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.pool.PoolStats()
+	ch <- prometheus.MustNewConstMetric(poolHitsDesc, prometheus.CounterValue, float64(stats.Hits))
+	ch <- prometheus.MustNewConstMetric(poolMissesDesc, prometheus.CounterValue, float64(stats.Misses))
+	ch <- prometheus.MustNewConstMetric(poolTimeoutsDesc, prometheus.CounterValue, float64(stats.Timeouts))
+	ch <- prometheus.MustNewConstMetric(poolTotalDesc, prometheus.GaugeValue, float64(stats.TotalConns))
+	ch <- prometheus.MustNewConstMetric(poolIdleDesc, prometheus.GaugeValue, float64(stats.IdleConns))
+	ch <- prometheus.MustNewConstMetric(poolStaleDesc, prometheus.CounterValue, float64(stats.StaleConns))
+}
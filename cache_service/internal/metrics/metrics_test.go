@@ -0,0 +1,75 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/cache_service.CacheService/GetUser"}
+
+	_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	})
+	assert.NoError(t, err)
+	_, err = interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.NotFound, "missing")
+	})
+	assert.Error(t, err)
+
+	assert.Equal(t, uint64(1), sampleCount(t, "GetUser", "OK"))
+	assert.Equal(t, uint64(1), sampleCount(t, "GetUser", "NotFound"))
+}
+
+func sampleCount(t *testing.T, method, result string) uint64 {
+	t.Helper()
+	var m dto.Metric
+	assert.NoError(t, cacheLatency.WithLabelValues(method, result).(prometheus.Metric).Write(&m))
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestHitMiss(t *testing.T) {
+	Hit("GetUser")
+	Hit("GetUser")
+	Miss("GetUser")
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(cacheHits.WithLabelValues("GetUser")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(cacheMisses.WithLabelValues("GetUser")))
+}
+
+type fakePool redis.PoolStats
+
+func (p *fakePool) PoolStats() *redis.PoolStats {
+	stats := redis.PoolStats(*p)
+	return &stats
+}
+
+func TestPoolCollector(t *testing.T) {
+	collector := NewPoolCollector(&fakePool{Hits: 10, Misses: 2, Timeouts: 1, TotalConns: 5, IdleConns: 3})
+
+	expected := `
+# HELP redis_pool_idle_conns Idle connections in the pool
+# TYPE redis_pool_idle_conns gauge
+redis_pool_idle_conns 3
+# HELP redis_pool_timeouts_total Times a wait for a connection timed out
+# TYPE redis_pool_timeouts_total counter
+redis_pool_timeouts_total 1
+# HELP redis_pool_total_conns Connections in the pool
+# TYPE redis_pool_total_conns gauge
+redis_pool_total_conns 5
+`
+	assert.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(expected),
+		"redis_pool_idle_conns", "redis_pool_timeouts_total", "redis_pool_total_conns"))
+	assert.Equal(t, 6, testutil.CollectAndCount(collector))
+}
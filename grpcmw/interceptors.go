@@ -0,0 +1,128 @@
+package grpcmw
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryClientInterceptors - цепочка для grpc.WithChainUnaryInterceptor:
+// request ID в metadata, метрики и лог каждого вызова
+func UnaryClientInterceptors(m *Metrics, logger *slog.Logger) []grpc.UnaryClientInterceptor {
+	return []grpc.UnaryClientInterceptor{
+		clientRequestID,
+		clientObserve(m, logger),
+	}
+}
+
+// UnaryServerInterceptors - цепочка для grpc.ChainUnaryInterceptor. Recovery
+// последний, чтобы паника обработчика попала в метрики и лог как Internal.
+func UnaryServerInterceptors(m *Metrics, logger *slog.Logger) []grpc.UnaryServerInterceptor {
+	return []grpc.UnaryServerInterceptor{
+		serverRequestID,
+		serverObserve(m, logger),
+		serverRecovery(logger),
+	}
+}
+
+func clientRequestID(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+) error {
+	if id := RequestID(ctx); id != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, RequestIDKey, id)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func clientObserve(m *Metrics, logger *slog.Logger) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+	) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		elapsed := time.Since(start)
+
+		service, name := splitMethod(method)
+		code := status.Code(err)
+		m.clientHandled.WithLabelValues(service, name, code.String()).Inc()
+		m.clientDuration.WithLabelValues(service, name).Observe(elapsed.Seconds())
+		logCall(ctx, logger, "grpc client call", method, code, elapsed, err)
+		return err
+	}
+}
+
+// serverRequestID берёт request ID из metadata, а если его нет - создаёт новый
+func serverRequestID(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	id := incomingRequestID(ctx)
+	if id == "" {
+		id = NewRequestID()
+	}
+	return handler(WithRequestID(ctx, id), req)
+}
+
+func serverObserve(m *Metrics, logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		elapsed := time.Since(start)
+
+		service, name := splitMethod(info.FullMethod)
+		code := status.Code(err)
+		m.serverHandled.WithLabelValues(service, name, code.String()).Inc()
+		m.serverDuration.WithLabelValues(service, name).Observe(elapsed.Seconds())
+		logCall(ctx, logger, "grpc server call", info.FullMethod, code, elapsed, err)
+		return resp, err
+	}
+}
+
+// serverRecovery превращает панику обработчика в codes.Internal, чтобы один
+// запрос не ронял весь сервер
+func serverRecovery(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if p := recover(); p != nil {
+				logger.ErrorContext(ctx, "grpc handler panic",
+					slog.String("method", info.FullMethod),
+					slog.String("request_id", RequestID(ctx)),
+					slog.Any("panic", p),
+					slog.String("stack", string(debug.Stack())),
+				)
+				resp, err = nil, status.Error(codes.Internal, "internal error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+func logCall(ctx context.Context, logger *slog.Logger, msg, method string, code codes.Code, elapsed time.Duration, err error) {
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("code", code.String()),
+		slog.Duration("duration", elapsed),
+	}
+	if id := RequestID(ctx); id != "" {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
+	level := slog.LevelInfo
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		level = errorLevel(code)
+	}
+	logger.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// errorLevel: ошибки сервера - Error, ошибки клиента (неверный запрос, нет доступа) - Warn
+func errorLevel(code codes.Code) slog.Level {
+	switch code {
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DataLoss,
+		codes.DeadlineExceeded, codes.Unimplemented:
+		return slog.LevelError
+	}
+	return slog.LevelWarn
+}
@@ -0,0 +1,93 @@
+package grpcmw
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequestIDAndMetrics(t *testing.T) {
+	m := NewMetrics()
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+
+	var seen []string
+	capture := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		seen = append(seen, RequestID(ctx))
+		return handler(ctx, req)
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(append(UnaryServerInterceptors(m, logger), capture)...))
+	healthpb.RegisterHealthServer(srv, grpchealth.NewServer())
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(UnaryClientInterceptors(m, logger)...),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+
+	_, err = client.Check(WithRequestID(context.Background(), "req-1"), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	_, err = client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	require.Len(t, seen, 2)
+	assert.Equal(t, "req-1", seen[0])
+	// Без request ID от клиента сервер создаёт свой
+	assert.Len(t, seen[1], 32)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.clientHandled.WithLabelValues("grpc.health.v1.Health", "Check", "OK")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.serverHandled.WithLabelValues("grpc.health.v1.Health", "Check", "NotFound")))
+	assert.Equal(t, 2, testutil.CollectAndCount(m, "grpc_client_handled_total"))
+	assert.Contains(t, logs.String(), `"request_id":"req-1"`)
+	assert.Contains(t, logs.String(), `"level":"WARN"`)
+}
+
+func TestServerRecovery(t *testing.T) {
+	m := NewMetrics()
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+	chain := UnaryServerInterceptors(m, logger)
+	info := &grpc.UnaryServerInfo{FullMethod: "/cache_service.CacheService/GetUser"}
+
+	// Собираем цепочку вручную, как это делает grpc.ChainUnaryInterceptor
+	var handler grpc.UnaryHandler = func(ctx context.Context, req any) (any, error) {
+		panic("boom")
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		interceptor, next := chain[i], handler
+		handler = func(ctx context.Context, req any) (any, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+
+	resp, err := handler(context.Background(), nil)
+	assert.Nil(t, resp)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.serverHandled.WithLabelValues("cache_service.CacheService", "GetUser", "Internal")))
+	assert.Contains(t, logs.String(), "grpc handler panic")
+}
+
+func TestSplitMethod(t *testing.T) {
+	service, method := splitMethod("/task_service.TaskService/GetTask")
+	assert.Equal(t, "task_service.TaskService", service)
+	assert.Equal(t, "GetTask", method)
+}
@@ -0,0 +1,75 @@
+package grpcmw
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics - латентность и коды ответов RPC. Реализует prometheus.Collector,
+// регистрируется вызывающей стороной.
+type Metrics struct {
+	clientHandled  *prometheus.CounterVec
+	clientDuration *prometheus.HistogramVec
+	serverHandled  *prometheus.CounterVec
+	serverDuration *prometheus.HistogramVec
+}
+
+func NewMetrics() *Metrics {
+	buckets := []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.3, 1, 3, 5}
+	return &Metrics{
+		clientHandled: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "grpc_client_handled_total",
+				Help: "Total RPCs completed by the client",
+			},
+			[]string{"grpc_service", "grpc_method", "grpc_code"},
+		),
+		clientDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "grpc_client_handling_seconds",
+				Help:    "RPC latency seen by the client",
+				Buckets: buckets,
+			},
+			[]string{"grpc_service", "grpc_method"},
+		),
+		serverHandled: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "grpc_server_handled_total",
+				Help: "Total RPCs completed by the server",
+			},
+			[]string{"grpc_service", "grpc_method", "grpc_code"},
+		),
+		serverDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "grpc_server_handling_seconds",
+				Help:    "RPC latency of the server handler",
+				Buckets: buckets,
+			},
+			[]string{"grpc_service", "grpc_method"},
+		),
+	}
+}
+
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.clientHandled.Describe(ch)
+	m.clientDuration.Describe(ch)
+	m.serverHandled.Describe(ch)
+	m.serverDuration.Describe(ch)
+}
+
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.clientHandled.Collect(ch)
+	m.clientDuration.Collect(ch)
+	m.serverHandled.Collect(ch)
+	m.serverDuration.Collect(ch)
+}
+
+// splitMethod разбирает "/pkg.Service/Method" на сервис и метод
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
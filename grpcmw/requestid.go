@@ -0,0 +1,48 @@
+// Package grpcmw - общие gRPC interceptor'ы api_service и cache_service:
+// метрики, логирование с request ID и восстановление после паники
+package grpcmw
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDKey - ключ request ID в gRPC metadata
+const RequestIDKey = "x-request-id"
+
+type requestIDKey struct{}
+
+// WithRequestID сохраняет request ID в контексте
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID возвращает request ID из контекста или пустую строку
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// NewRequestID - случайный идентификатор из 16 байт в hex
+func NewRequestID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b[:])
+}
+
+// incomingRequestID берёт request ID из metadata входящего вызова
+func incomingRequestID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if ids := md.Get(RequestIDKey); len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
+}
@@ -0,0 +1,155 @@
+// Package configload - общий загрузчик конфигурации Go сервисов: значения по
+// умолчанию, затем YAML файл (--config или CONFIG_FILE), затем переменные
+// окружения, затем флаги
+package configload
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Field связывает поле конфигурации с переменной окружения и флагом
+type Field struct {
+	Flag  string
+	Env   string
+	Usage string
+	Value flag.Value
+}
+
+// Load собирает конфигурацию. newConfig возвращает конфигурацию со значениями
+// по умолчанию и список её полей; второй результат - запрошен ли --print-config.
+// Проверка значений остаётся за сервисом.
+func Load[T any](name string, args []string, lookupEnv func(string) (string, bool), output io.Writer,
+	newConfig func() (*T, []Field),
+) (*T, bool, error) {
+	// Флаги разбираются в отдельный экземпляр, чтобы применить их последними
+	_, parsed := newConfig()
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(output)
+	configFile := fs.String("config", "", "path to YAML config file (env CONFIG_FILE)")
+	printConfig := fs.Bool("print-config", false, "print the effective configuration and exit")
+	for _, f := range parsed {
+		fs.Var(f.Value, f.Flag, fmt.Sprintf("%s (env %s)", f.Usage, f.Env))
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, false, err
+	}
+
+	cfg, cfgFields := newConfig()
+	path := *configFile
+	if path == "" {
+		path, _ = lookupEnv("CONFIG_FILE")
+	}
+	if path != "" {
+		if err := loadFile(cfg, path); err != nil {
+			return nil, false, err
+		}
+	}
+
+	fields := make(map[string]Field)
+	for _, f := range cfgFields {
+		fields[f.Flag] = f
+		if v, ok := lookupEnv(f.Env); ok {
+			if err := f.Value.Set(v); err != nil {
+				return nil, false, fmt.Errorf("invalid %s: %w", f.Env, err)
+			}
+		}
+	}
+	var err error
+	fs.Visit(func(fl *flag.Flag) {
+		f, ok := fields[fl.Name]
+		if !ok || err != nil {
+			return
+		}
+		if setErr := f.Value.Set(fl.Value.String()); setErr != nil {
+			err = fmt.Errorf("invalid -%s: %w", fl.Name, setErr)
+		}
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return cfg, *printConfig, nil
+}
+
+func loadFile(cfg any, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("Error reading config: %w", err)
+	}
+	defer f.Close()
+
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && err != io.EOF {
+		return fmt.Errorf("Error parsing config %s: %w", path, err)
+	}
+	return nil
+}
+
+// Redact скрывает непустой секрет при выводе конфигурации
+func Redact(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "<redacted>"
+}
+
+type String string
+
+func (v *String) Set(s string) error { *v = String(s); return nil }
+func (v *String) String() string     { return string(*v) }
+
+type Int int
+
+func (v *Int) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+	*v = Int(n)
+	return nil
+}
+func (v *Int) String() string { return strconv.Itoa(int(*v)) }
+
+type Duration time.Duration
+
+func (v *Duration) Set(s string) error {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*v = Duration(d)
+	return nil
+}
+func (v *Duration) String() string { return time.Duration(*v).String() }
+
+type Bool bool
+
+func (v *Bool) Set(s string) error {
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	*v = Bool(b)
+	return nil
+}
+func (v *Bool) String() string   { return strconv.FormatBool(bool(*v)) }
+func (v *Bool) IsBoolFlag() bool { return true }
+
+type Float float64
+
+func (v *Float) Set(s string) error {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return err
+	}
+	*v = Float(f)
+	return nil
+}
+func (v *Float) String() string { return strconv.FormatFloat(float64(*v), 'g', -1, 64) }
@@ -0,0 +1,66 @@
+package configload
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testConfig struct {
+	Addr    string        `yaml:"addr"`
+	Workers int           `yaml:"workers"`
+	Timeout time.Duration `yaml:"timeout"`
+	Debug   bool          `yaml:"debug"`
+}
+
+func newTestConfig() (*testConfig, []Field) {
+	c := &testConfig{Addr: ":8080", Workers: 1, Timeout: time.Second}
+	return c, []Field{
+		{"addr", "ADDR", "listen address", (*String)(&c.Addr)},
+		{"workers", "WORKERS", "worker count", (*Int)(&c.Workers)},
+		{"timeout", "TIMEOUT", "request timeout", (*Duration)(&c.Timeout)},
+		{"debug", "DEBUG", "debug mode", (*Bool)(&c.Debug)},
+	}
+}
+
+func env(vars map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		v, ok := vars[key]
+		return v, ok
+	}
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("addr: \":9000\"\nworkers: 4\ntimeout: 5s\n"), 0o600))
+
+	cfg, printOnly, err := Load("test", []string{"--workers", "8", "--debug", "--print-config"},
+		env(map[string]string{"CONFIG_FILE": path, "WORKERS": "6", "TIMEOUT": "3s"}), io.Discard, newTestConfig)
+	require.NoError(t, err)
+	assert.True(t, printOnly)
+	// Файл важнее значений по умолчанию, окружение - файла, флаги - окружения
+	assert.Equal(t, testConfig{Addr: ":9000", Workers: 8, Timeout: 3 * time.Second, Debug: true}, *cfg)
+}
+
+func TestLoadErrors(t *testing.T) {
+	_, _, err := Load("test", nil, env(map[string]string{"WORKERS": "many"}), io.Discard, newTestConfig)
+	assert.ErrorContains(t, err, "invalid WORKERS")
+
+	_, _, err = Load("test", []string{"--timeout", "soon"}, env(nil), io.Discard, newTestConfig)
+	assert.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("unknown: 1\n"), 0o600))
+	_, _, err = Load("test", []string{"--config", path}, env(nil), io.Discard, newTestConfig)
+	assert.ErrorContains(t, err, "Error parsing config")
+}
+
+func TestRedact(t *testing.T) {
+	assert.Equal(t, "", Redact(""))
+	assert.Equal(t, "<redacted>", Redact("hunter2"))
+}
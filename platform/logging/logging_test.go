@@ -0,0 +1,41 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJSON(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(&buf, "warn", "json")
+	require.NoError(t, err)
+
+	logger.Info("skipped")
+	logger.Warn("written", "user_id", 7)
+
+	var rec map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &rec))
+	assert.Equal(t, "WARN", rec["level"])
+	assert.Equal(t, "written", rec["msg"])
+	assert.Equal(t, float64(7), rec["user_id"])
+}
+
+func TestNewText(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(&buf, "debug", "text")
+	require.NoError(t, err)
+
+	logger.Debug("hello")
+	assert.Contains(t, buf.String(), "level=DEBUG msg=hello")
+}
+
+func TestNewInvalid(t *testing.T) {
+	_, err := New(&bytes.Buffer{}, "loud", "json")
+	assert.Error(t, err)
+	_, err = New(&bytes.Buffer{}, "info", "xml")
+	assert.Error(t, err)
+}
@@ -0,0 +1,44 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestSpansToFile(t *testing.T) {
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+	file := filepath.Join(t.TempDir(), "spans.json")
+	shutdown, err := Setup(context.Background(), "cache_service", Options{
+		Exporter:    "file",
+		File:        file,
+		SampleRatio: 1,
+	})
+	require.NoError(t, err)
+
+	_, span := otel.Tracer("test").Start(context.Background(), "GetUser")
+	span.End()
+
+	require.NoError(t, shutdown(context.Background()))
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"Name":"GetUser"`)
+	assert.Contains(t, string(data), `"Value":"cache_service"`)
+}
+
+func TestSetupNone(t *testing.T) {
+	shutdown, err := Setup(context.Background(), "api_service", Options{Exporter: "none"})
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+}
+
+func TestUnknownExporter(t *testing.T) {
+	_, err := Setup(context.Background(), "api_service", Options{Exporter: "zipkin"})
+	assert.ErrorContains(t, err, "unknown tracing exporter")
+}
@@ -0,0 +1,42 @@
+"""OpenTelemetry для gRPC сервера: спан на каждый вызов, контекст трассы
+берётся из W3C traceparent в metadata (его передаёт api_service).
+Общий для user_service и task_service, копируется в образ при сборке."""
+import logging
+import os
+
+from opentelemetry import trace
+from opentelemetry.instrumentation.grpc import GrpcInstrumentorServer
+from opentelemetry.sdk.resources import Resource
+from opentelemetry.sdk.trace import TracerProvider
+from opentelemetry.sdk.trace.export import BatchSpanProcessor, ConsoleSpanExporter
+from opentelemetry.sdk.trace.sampling import ParentBased, TraceIdRatioBased
+
+logger = logging.getLogger(__name__)
+
+
+def _exporter(name: str):
+    if name == "otlp":
+        from opentelemetry.exporter.otlp.proto.grpc.trace_exporter import OTLPSpanExporter
+        return OTLPSpanExporter(endpoint=os.environ["TRACING_ENDPOINT"], insecure=True)
+    if name == "stdout":
+        return ConsoleSpanExporter()
+    if name == "file":
+        return ConsoleSpanExporter(out=open(os.environ["TRACING_FILE"], "a"))
+    raise ValueError(f"unknown TRACING_EXPORTER {name!r}")
+
+
+def setup(service_name: str) -> None:
+    """Настройки те же, что у Go сервисов: TRACING_EXPORTER (none, otlp, stdout, file),
+    TRACING_ENDPOINT, TRACING_FILE, TRACING_SAMPLE_RATIO. Вызывать до grpc.server()."""
+    exporter_name = os.getenv("TRACING_EXPORTER", "none")
+    if exporter_name != "none":
+        ratio = float(os.getenv("TRACING_SAMPLE_RATIO", "1"))
+        provider = TracerProvider(
+            resource=Resource.create({"service.name": service_name}),
+            sampler=ParentBased(TraceIdRatioBased(ratio)),
+        )
+        provider.add_span_processor(BatchSpanProcessor(_exporter(exporter_name)))
+        trace.set_tracer_provider(provider)
+        logger.info(f"Tracing enabled with {exporter_name} exporter")
+    # Без экспортёра спаны не пишутся, но интерсептор всё равно ставится
+    GrpcInstrumentorServer().instrument()
@@ -1,7 +1,20 @@
+from datetime import datetime
 from sqlalchemy.orm import Session
 from sqlalchemy import desc, func
 from models import *
 from schemas import *
+from repos.pagination import SortKey, paginate
+
+# Порядок списка задач по умолчанию: сначала важные, затем по сроку
+TASK_ORDER = [SortKey(Task.priority, descending=True), SortKey(Task.due_time)]
+
+# Поля, по которым можно сортировать список задач
+TASK_SORT_COLUMNS = {
+    "due_time": Task.due_time,
+    "priority": Task.priority,
+    "created_at": Task.created_at,
+    "title": Task.title,
+}
 
 class TaskRepo:
     @staticmethod
@@ -36,6 +49,32 @@ class TaskRepo:
             Task.folder_id == folder_id
         ).order_by(Task.priority.desc(), Task.due_time).all()
     
+    @staticmethod
+    def list_tasks(db: Session, user_id: int, task_filter: TaskListFilter,
+                   order: Optional[List[SortKey]], pagination):
+        """Page of filtered user tasks and total count"""
+        query = db.query(Task).filter(Task.user_id == user_id)
+        if task_filter.folder_id is not None:
+            query = query.filter(Task.folder_id == task_filter.folder_id)
+        if task_filter.due_after is not None:
+            query = query.filter(Task.due_time >= task_filter.due_after)
+        if task_filter.due_before is not None:
+            query = query.filter(Task.due_time <= task_filter.due_before)
+        if task_filter.created_after is not None:
+            query = query.filter(Task.created_at >= task_filter.created_after)
+        if task_filter.priority_in:
+            query = query.filter(Task.priority.in_(task_filter.priority_in))
+        if task_filter.completed is not None:
+            query = query.filter(Task.is_completed == task_filter.completed)
+        if task_filter.overdue:
+            query = query.filter(
+                Task.due_time < datetime.now(),
+                Task.is_completed.is_(False)
+            )
+        total = query.count()
+        tasks, next_position = paginate(query, order or TASK_ORDER, Task.task_id, pagination)
+        return tasks, total, next_position
+
     @staticmethod
     def update_task(db: Session, task_data: TaskUpdate) -> Optional[Task]:
         """Update task"""
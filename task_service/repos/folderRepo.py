@@ -2,6 +2,7 @@ from sqlalchemy.orm import Session
 from sqlalchemy import desc, func
 from models import *
 from schemas import *
+from repos.pagination import SortKey, paginate
 
 class FolderRepo:
     @staticmethod
@@ -31,6 +32,12 @@ class FolderRepo:
             TaskFolder.user_id == user_id
         ).order_by(TaskFolder.created_at).all()
     
+    @staticmethod
+    def list_user_folders(db: Session, user_id: int, pagination):
+        """Page of user's folders ordered by creation time"""
+        query = db.query(TaskFolder).filter(TaskFolder.user_id == user_id)
+        return paginate(query, [SortKey(TaskFolder.created_at)], TaskFolder.folder_id, pagination)
+    
     @staticmethod
     def update_folder(db: Session, folder_data : FolderUpdate)->Optional[TaskFolder]:
         """Update folder's name"""
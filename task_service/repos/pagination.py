@@ -0,0 +1,86 @@
+from datetime import datetime
+from typing import List, Optional, Tuple
+
+from sqlalchemy import and_, func, or_
+
+# Подставляется вместо NULL в датах, чтобы строки без срока шли в конце и
+# участвовали в сравнении курсора
+NULL_DATETIME = datetime(9999, 12, 31)
+
+DEFAULT_LIMIT = 50
+
+
+class SortKey:
+    """Колонка сортировки для keyset-пагинации"""
+
+    def __init__(self, column, descending: bool = False):
+        self.column = column
+        self.descending = descending
+
+    @property
+    def is_datetime(self) -> bool:
+        return self.column.type.python_type is datetime
+
+    @property
+    def expr(self):
+        if self.is_datetime:
+            return func.coalesce(self.column, NULL_DATETIME)
+        return self.column
+
+    def encode(self, value) -> str:
+        if self.is_datetime:
+            return (value or NULL_DATETIME).isoformat()
+        return str(value)
+
+    def decode(self, value: str):
+        python_type = self.column.type.python_type
+        if python_type is datetime:
+            return datetime.fromisoformat(value)
+        if python_type is bool:
+            return value == "True"
+        return python_type(value)
+
+
+def _after(keys: List[SortKey], id_column, values: list, last_id: int):
+    """Условие "строка идёт после курсора" для составного ключа (keys..., id)"""
+    clauses = []
+    for i in range(len(keys) + 1):
+        equal = [keys[j].expr == values[j] for j in range(i)]
+        if i < len(keys):
+            key = keys[i]
+            cmp = key.expr < values[i] if key.descending else key.expr > values[i]
+        else:
+            cmp = id_column > last_id
+        clauses.append(and_(*equal, cmp))
+    return or_(*clauses)
+
+
+def paginate(query, keys: List[SortKey], id_column, pagination) -> Tuple[list, Optional[Tuple[List[str], int]]]:
+    """Применяет сортировку и пагинацию. Возвращает строки и позицию для следующей
+    страницы (значения ключей, id) или None, если страница последняя.
+
+    Без pagination возвращает все строки, как раньше.
+    """
+    order = [k.expr.desc() if k.descending else k.expr.asc() for k in keys]
+    query = query.order_by(*order, id_column.asc())
+
+    if pagination is None:
+        return query.all(), None
+
+    limit = pagination.limit or DEFAULT_LIMIT
+    if pagination.HasField("after"):
+        if len(pagination.after.sort_values) != len(keys):
+            raise ValueError("cursor does not match sort order")
+        values = [k.decode(v) for k, v in zip(keys, pagination.after.sort_values)]
+        query = query.filter(_after(keys, id_column, values, pagination.after.id))
+    elif pagination.offset:
+        query = query.offset(pagination.offset)
+
+    rows = query.limit(limit + 1).all()
+    if len(rows) <= limit:
+        return rows, None
+
+    rows = rows[:limit]
+    last = rows[-1]
+    values = [k.encode(getattr(last, k.column.key)) for k in keys]
+    return rows, (values, getattr(last, id_column.key))
@@ -39,6 +39,15 @@ class TaskResponse(TaskBase):
             datetime: lambda v: v.isoformat()  # Правильное форматирование datetime
         }
 
+class TaskListFilter(BaseModel):
+    folder_id: Optional[int] = Field(None, description="ID папки")
+    due_after: Optional[datetime] = Field(None, description="Срок не раньше")
+    due_before: Optional[datetime] = Field(None, description="Срок не позже")
+    created_after: Optional[datetime] = Field(None, description="Создана не раньше")
+    priority_in: List[conint(ge=1, le=5)] = Field(default_factory=list, description="Допустимые приоритеты")
+    completed: Optional[bool] = Field(None, description="Статус выполнения")
+    overdue: bool = Field(False, description="Только просроченные невыполненные")
+
 class FolderBase(BaseModel):
     folder_name: str = Field(
         ..., 
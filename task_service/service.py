@@ -7,9 +7,10 @@ import database
 import logging
 from datetime import datetime, timedelta
 import os
-from typing import Optional
+from typing import List, Optional
 from repos.folderRepo import *
 from repos.TaskRepo import *
+from repos.pagination import SortKey, paginate
 from google.protobuf.timestamp_pb2 import Timestamp
 
 # Настройка логирования
@@ -282,10 +283,19 @@ class TaskService(task_pb2_grpc.TaskServiceServicer):
     def GetUserFolders(self, request, context):
         """Get all folders for user"""
         try:
-            folders = FolderRepo.get_user_folders(self.db, request.user_id)
+            folders, next_position = FolderRepo.list_user_folders(
+                self.db,
+                request.user_id,
+                self._pagination(request)
+            )
             return task_pb2.GetFoldersResponse(
-                folders=[self._folder_to_proto(f) for f in folders]
+                folders=[self._folder_to_proto(f) for f in folders],
+                next_cursor=self._cursor(next_position)
             )
+        except ValueError as e:
+            context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+            context.set_details(str(e))
+            return task_pb2.GetFoldersResponse()
         except Exception as e:
             context.set_code(grpc.StatusCode.INTERNAL)
             context.set_details(str(e))
@@ -295,28 +305,31 @@ class TaskService(task_pb2_grpc.TaskServiceServicer):
     def GetAllTasks(self, request, context):
         """Get all tasks for user (optionally filtered by folder)"""
         try:
-            if request.HasField('folder_id'):
-                tasks = TaskRepo.get_folder_tasks(
-                    self.db, 
-                    request.user_id, 
-                    request.folder_id
-                )
-            else:
-                # Получаем задачи из всех папок пользователя
-                tasks = []
-                folders = FolderRepo.get_user_folders(self.db, request.user_id)
-                for folder in folders:
-                    tasks.extend(
-                        TaskRepo.get_folder_tasks(
-                            self.db, 
-                            request.user_id, 
-                            folder.folder_id
-                        )
-                    )
+            tasks, total, next_position = TaskRepo.list_tasks(
+                self.db,
+                request.user_id,
+                TaskListFilter(
+                    folder_id=self._optional(request, 'folder_id'),
+                    due_after=self._optional_datetime(request, 'due_after'),
+                    due_before=self._optional_datetime(request, 'due_before'),
+                    created_after=self._optional_datetime(request, 'created_after'),
+                    priority_in=list(request.priority_in),
+                    completed=self._optional(request, 'completed'),
+                    overdue=request.overdue
+                ),
+                self._sort_keys(request.sort),
+                self._pagination(request)
+            )
             
             return task_pb2.GetAllTasksResponse(
-                tasks=[self._task_to_proto(t) for t in tasks]
+                tasks=[self._task_to_proto(t) for t in tasks],
+                total_count=total,
+                next_cursor=self._cursor(next_position)
             )
+        except ValueError as e:
+            context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+            context.set_details(str(e))
+            return task_pb2.GetAllTasksResponse()
         except Exception as e:
             context.set_code(grpc.StatusCode.INTERNAL)
             context.set_details(str(e))
@@ -409,7 +422,7 @@ class TaskService(task_pb2_grpc.TaskServiceServicer):
                     (Task.description.ilike(f"%{request.query}%"))
                 )
             
-            if request.completed is not None:
+            if request.HasField('completed'):
                 query = query.filter(
                     Task.is_completed == request.completed
                 )
@@ -426,15 +439,23 @@ class TaskService(task_pb2_grpc.TaskServiceServicer):
                 )
             
             # Применяем пагинацию
-            tasks = query.order_by(Task.due_time.asc())\
-                       .offset(request.pagination.offset)\
-                       .limit(request.pagination.limit)\
-                       .all()
+            total = query.count()
+            tasks, next_position = paginate(
+                query,
+                [SortKey(Task.due_time)],
+                Task.task_id,
+                self._pagination(request)
+            )
             
             return task_pb2.SearchTasksResponse(
                 tasks=[self._task_to_proto(t) for t in tasks],
-                total_count=query.count()
+                total_count=total,
+                next_cursor=self._cursor(next_position)
             )
+        except ValueError as e:
+            context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+            context.set_details(str(e))
+            return task_pb2.SearchTasksResponse()
         except Exception as e:
             context.set_code(grpc.StatusCode.INTERNAL)
             context.set_details(str(e))
@@ -442,6 +463,36 @@ class TaskService(task_pb2_grpc.TaskServiceServicer):
             return task_pb2.SearchTasksResponse()
 
     # ========== Utility Methods ==========
+
+    def _pagination(self, request):
+        """Pagination from request or None for old clients without it"""
+        return request.pagination if request.HasField('pagination') else None
+
+    def _optional(self, request, field: str):
+        """Value of proto3 optional field or None if it is not set"""
+        return getattr(request, field) if request.HasField(field) else None
+
+    def _optional_datetime(self, request, field: str) -> Optional[datetime]:
+        if not request.HasField(field):
+            return None
+        return self._proto_to_datetime(getattr(request, field))
+
+    def _sort_keys(self, sort) -> Optional[List[SortKey]]:
+        """Convert repeated SortField to SortKey list, None means default order"""
+        keys = []
+        for field in sort:
+            name = task_pb2.SortField.Field.Name(field.field).lower()
+            if name not in TASK_SORT_COLUMNS:
+                raise ValueError(f"unsupported sort field: {name}")
+            keys.append(SortKey(TASK_SORT_COLUMNS[name], descending=field.descending))
+        return keys or None
+
+    def _cursor(self, position) -> Optional[task_pb2.Cursor]:
+        """Convert (sort values, id) of the last row to protobuf Cursor"""
+        if position is None:
+            return None
+        values, last_id = position
+        return task_pb2.Cursor(sort_values=values, id=last_id)
     
     def _folder_to_proto(self, folder: TaskFolder) -> task_pb2.Folder:
         """Convert SQLAlchemy Folder to protobuf message"""
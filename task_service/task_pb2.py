@@ -25,7 +25,7 @@ _sym_db = _symbol_database.Default()
 from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__pb2
 import validate_pb2 as validate_dot_validate__pb2
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\ntask.proto\x12\x0ctask_service\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"6\n\nPagination\x12\x18\n\x05limit\x18\x01 \x01(\x05\x42\t\xfa\x42\x06\x1a\x04\x18\x64(\x01\x12\x0e\n\x06offset\x18\x02 \x01(\x05\"\x90\x01\n\x06\x46older\x12\x11\n\tfolder_id\x18\x01 \x01(\x05\x12\x18\n\x07user_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x17\n\x04name\x18\x03 \x01(\tB\t\xfa\x42\x06r\x04\x10\x01\x18\x32\x12.\n\ncreated_at\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x10\n\x08task_ids\x18\x05 \x03(\x05\"-\n\x11GetFoldersRequest\x12\x18\n\x07user_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\";\n\x12GetFoldersResponse\x12%\n\x07\x66olders\x18\x01 \x03(\x0b\x32\x14.task_service.Folder\"H\n\x10GetFolderRequest\x12\x18\n\x07user_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x1a\n\tfolder_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\"J\n\x11GetFolderResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12$\n\x06\x66older\x18\x02 \x01(\x0b\x32\x14.task_service.Folder\"H\n\x13\x43reateFolderRequest\x12\x18\n\x07user_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x17\n\x04name\x18\x02 \x01(\tB\t\xfa\x42\x06r\x04\x10\x01\x18\x32\"M\n\x14\x43reateFolderResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12$\n\x06\x66older\x18\x02 \x01(\x0b\x32\x14.task_service.Folder\"h\n\x13UpdateFolderRequest\x12\x1a\n\tfolder_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x07user_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x1b\n\x08new_name\x18\x03 \x01(\tB\t\xfa\x42\x06r\x04\x10\x01\x18\x32\"M\n\x14UpdateFolderResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12$\n\x06\x66older\x18\x02 \x01(\x0b\x32\x14.task_service.Folder\"K\n\x13\x44\x65leteFolderRequest\x12\x1a\n\tfolder_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x07user_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\"8\n\x14\x44\x65leteFolderResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\"\xc7\x02\n\x04Task\x12\x0f\n\x07task_id\x18\x01 \x01(\x05\x12\x1a\n\tfolder_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x07user_id\x18\x03 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x05title\x18\x04 \x01(\tB\t\xfa\x42\x06r\x04\x10\x01\x18\x64\x12\x1d\n\x0b\x64\x65scription\x18\x05 \x01(\tB\x08\xfa\x42\x05r\x03\x18\xf4\x03\x12,\n\x08\x64ue_time\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x1b\n\x08priority\x18\x07 \x01(\x05\x42\t\xfa\x42\x06\x1a\x04\x18\x05(\x01\x12\x14\n\x0cis_completed\x18\x08 \x01(\x08\x12.\n\ncreated_at\x18\t \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\n \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\xcd\x01\n\x11\x43reateTaskRequest\x12\x18\n\x07user_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x1a\n\tfolder_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x05title\x18\x03 \x01(\tB\t\xfa\x42\x06r\x04\x10\x01\x18\x64\x12\x1d\n\x0b\x64\x65scription\x18\x04 \x01(\tB\x08\xfa\x42\x05r\x03\x18\xf4\x03\x12,\n\x08\x64ue_time\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x1b\n\x08priority\x18\x06 \x01(\x05\x42\t\xfa\x42\x06\x1a\x04\x18\x05(\x01\"G\n\x12\x43reateTaskResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12 \n\x04task\x18\x02 \x01(\x0b\x32\x12.task_service.Task\"D\n\x0eGetTaskRequest\x12\x18\n\x07user_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x07task_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\"D\n\x0fGetTaskResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12 \n\x04task\x18\x02 \x01(\x0b\x32\x12.task_service.Task\"\xc2\x02\n\x11UpdateTaskRequest\x12\x18\n\x07task_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x07user_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x1f\n\tfolder_id\x18\x03 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00H\x00\x88\x01\x01\x12\x1d\n\x05title\x18\x04 \x01(\tB\t\xfa\x42\x06r\x04\x10\x01\x18\x64H\x01\x88\x01\x01\x12\"\n\x0b\x64\x65scription\x18\x05 \x01(\tB\x08\xfa\x42\x05r\x03\x18\xf4\x03H\x02\x88\x01\x01\x12\x31\n\x08\x64ue_time\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x03\x88\x01\x01\x12 \n\x08priority\x18\x07 \x01(\x05\x42\t\xfa\x42\x06\x1a\x04\x18\x05(\x01H\x04\x88\x01\x01\x42\x0c\n\n_folder_idB\x08\n\x06_titleB\x0e\n\x0c_descriptionB\x0b\n\t_due_timeB\x0b\n\t_priority\"G\n\x12UpdateTaskResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12 \n\x04task\x18\x02 \x01(\x0b\x32\x12.task_service.Task\"G\n\x11\x44\x65leteTaskRequest\x12\x18\n\x07task_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x07user_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\"6\n\x12\x44\x65leteTaskResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\"G\n\x11ToggleTaskRequest\x12\x18\n\x07task_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x07user_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\"0\n\x0cTaskResponse\x12 \n\x04task\x18\x01 \x01(\x0b\x32\x12.task_service.Task\"e\n\x0fMoveTaskRequest\x12\x18\n\x07task_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x07user_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x1e\n\rnew_folder_id\x18\x03 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\"\x8d\x02\n\x12SearchTasksRequest\x12\x18\n\x07user_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x16\n\x05query\x18\x02 \x01(\tB\x07\xfa\x42\x04r\x02\x10\x01\x12\x16\n\tcompleted\x18\x03 \x01(\x08H\x00\x88\x01\x01\x12 \n\x08priority\x18\x04 \x01(\x05\x42\t\xfa\x42\x06\x1a\x04\x18\x05(\x01H\x01\x88\x01\x01\x12\x33\n\ndue_before\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x02\x88\x01\x01\x12,\n\npagination\x18\x06 \x01(\x0b\x32\x18.task_service.PaginationB\x0c\n\n_completedB\x0b\n\t_priorityB\r\n\x0b_due_before\"M\n\x13SearchTasksResponse\x12!\n\x05tasks\x18\x01 \x03(\x0b\x32\x12.task_service.Task\x12\x13\n\x0btotal_count\x18\x02 \x01(\x05\"T\n\x12GetAllTasksRequest\x12\x18\n\x07user_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x16\n\tfolder_id\x18\x02 \x01(\x05H\x00\x88\x01\x01\x42\x0c\n\n_folder_id\"M\n\x13GetAllTasksResponse\x12!\n\x05tasks\x18\x01 \x03(\x0b\x32\x12.task_service.Task\x12\x13\n\x0btotal_count\x18\x02 \x01(\x05\x32\xbc\x08\n\x0bTaskService\x12S\n\x0eGetUserFolders\x12\x1f.task_service.GetFoldersRequest\x1a .task_service.GetFoldersResponse\x12R\n\x0bGetAllTasks\x12 .task_service.GetAllTasksRequest\x1a!.task_service.GetAllTasksResponse\x12S\n\x14ToggleTaskCompletion\x12\x1f.task_service.ToggleTaskRequest\x1a\x1a.task_service.TaskResponse\x12M\n\x10MoveTaskToFolder\x12\x1d.task_service.MoveTaskRequest\x1a\x1a.task_service.TaskResponse\x12R\n\x0bSearchTasks\x12 .task_service.SearchTasksRequest\x1a!.task_service.SearchTasksResponse\x12U\n\x0c\x43reateFolder\x12!.task_service.CreateFolderRequest\x1a\".task_service.CreateFolderResponse\x12U\n\x0cUpdateFolder\x12!.task_service.UpdateFolderRequest\x1a\".task_service.UpdateFolderResponse\x12L\n\tGetFolder\x12\x1e.task_service.GetFolderRequest\x1a\x1f.task_service.GetFolderResponse\x12U\n\x0c\x44\x65leteFolder\x12!.task_service.DeleteFolderRequest\x1a\".task_service.DeleteFolderResponse\x12O\n\nCreateTask\x12\x1f.task_service.CreateTaskRequest\x1a .task_service.CreateTaskResponse\x12\x46\n\x07GetTask\x12\x1c.task_service.GetTaskRequest\x1a\x1d.task_service.GetTaskResponse\x12O\n\nUpdateTask\x12\x1f.task_service.UpdateTaskRequest\x1a .task_service.UpdateTaskResponse\x12O\n\nDeleteTask\x12\x1f.task_service.DeleteTaskRequest\x1a .task_service.DeleteTaskResponseb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\ntask.proto\x12\x0ctask_service\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"j\n\nPagination\x12\x18\n\x05limit\x18\x01 \x01(\x05\x42\t\xfa\x42\x06\x1a\x04\x18\x64(\x01\x12\x0e\n\x06offset\x18\x02 \x01(\x05\x12(\n\x05\x61\x66ter\x18\x03 \x01(\x0b\x32\x14.task_service.CursorH\x00\x88\x01\x01\x42\x08\n\x06_after\"2\n\x06\x43ursor\x12\x13\n\x0bsort_values\x18\x01 \x03(\t\x12\x13\n\x02id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\"\x90\x01\n\x06\x46older\x12\x11\n\tfolder_id\x18\x01 \x01(\x05\x12\x18\n\x07user_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x17\n\x04name\x18\x03 \x01(\tB\t\xfa\x42\x06r\x04\x10\x01\x18\x32\x12.\n\ncreated_at\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x10\n\x08task_ids\x18\x05 \x03(\x05\"[\n\x11GetFoldersRequest\x12\x18\n\x07user_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12,\n\npagination\x18\x02 \x01(\x0b\x32\x18.task_service.Pagination\"f\n\x12GetFoldersResponse\x12%\n\x07\x66olders\x18\x01 \x03(\x0b\x32\x14.task_service.Folder\x12)\n\x0bnext_cursor\x18\x02 \x01(\x0b\x32\x14.task_service.Cursor\"H\n\x10GetFolderRequest\x12\x18\n\x07user_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x1a\n\tfolder_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\"J\n\x11GetFolderResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12$\n\x06\x66older\x18\x02 \x01(\x0b\x32\x14.task_service.Folder\"H\n\x13\x43reateFolderRequest\x12\x18\n\x07user_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x17\n\x04name\x18\x02 \x01(\tB\t\xfa\x42\x06r\x04\x10\x01\x18\x32\"M\n\x14\x43reateFolderResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12$\n\x06\x66older\x18\x02 \x01(\x0b\x32\x14.task_service.Folder\"h\n\x13UpdateFolderRequest\x12\x1a\n\tfolder_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x07user_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x1b\n\x08new_name\x18\x03 \x01(\tB\t\xfa\x42\x06r\x04\x10\x01\x18\x32\"M\n\x14UpdateFolderResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12$\n\x06\x66older\x18\x02 \x01(\x0b\x32\x14.task_service.Folder\"K\n\x13\x44\x65leteFolderRequest\x12\x1a\n\tfolder_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x07user_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\"8\n\x14\x44\x65leteFolderResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\"\xc7\x02\n\x04Task\x12\x0f\n\x07task_id\x18\x01 \x01(\x05\x12\x1a\n\tfolder_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x07user_id\x18\x03 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x05title\x18\x04 \x01(\tB\t\xfa\x42\x06r\x04\x10\x01\x18\x64\x12\x1d\n\x0b\x64\x65scription\x18\x05 \x01(\tB\x08\xfa\x42\x05r\x03\x18\xf4\x03\x12,\n\x08\x64ue_time\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x1b\n\x08priority\x18\x07 \x01(\x05\x42\t\xfa\x42\x06\x1a\x04\x18\x05(\x01\x12\x14\n\x0cis_completed\x18\x08 \x01(\x08\x12.\n\ncreated_at\x18\t \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\n \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\xcd\x01\n\x11\x43reateTaskRequest\x12\x18\n\x07user_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x1a\n\tfolder_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x05title\x18\x03 \x01(\tB\t\xfa\x42\x06r\x04\x10\x01\x18\x64\x12\x1d\n\x0b\x64\x65scription\x18\x04 \x01(\tB\x08\xfa\x42\x05r\x03\x18\xf4\x03\x12,\n\x08\x64ue_time\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x1b\n\x08priority\x18\x06 \x01(\x05\x42\t\xfa\x42\x06\x1a\x04\x18\x05(\x01\"G\n\x12\x43reateTaskResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12 \n\x04task\x18\x02 \x01(\x0b\x32\x12.task_service.Task\"D\n\x0eGetTaskRequest\x12\x18\n\x07user_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x07task_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\"D\n\x0fGetTaskResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12 \n\x04task\x18\x02 \x01(\x0b\x32\x12.task_service.Task\"\xc2\x02\n\x11UpdateTaskRequest\x12\x18\n\x07task_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x07user_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x1f\n\tfolder_id\x18\x03 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00H\x00\x88\x01\x01\x12\x1d\n\x05title\x18\x04 \x01(\tB\t\xfa\x42\x06r\x04\x10\x01\x18\x64H\x01\x88\x01\x01\x12\"\n\x0b\x64\x65scription\x18\x05 \x01(\tB\x08\xfa\x42\x05r\x03\x18\xf4\x03H\x02\x88\x01\x01\x12\x31\n\x08\x64ue_time\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x03\x88\x01\x01\x12 \n\x08priority\x18\x07 \x01(\x05\x42\t\xfa\x42\x06\x1a\x04\x18\x05(\x01H\x04\x88\x01\x01\x42\x0c\n\n_folder_idB\x08\n\x06_titleB\x0e\n\x0c_descriptionB\x0b\n\t_due_timeB\x0b\n\t_priority\"G\n\x12UpdateTaskResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12 \n\x04task\x18\x02 \x01(\x0b\x32\x12.task_service.Task\"G\n\x11\x44\x65leteTaskRequest\x12\x18\n\x07task_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x07user_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\"6\n\x12\x44\x65leteTaskResponse\x12\x0f\n\x07success\x18\x01 \x01(\x08\x12\x0f\n\x07message\x18\x02 \x01(\t\"G\n\x11ToggleTaskRequest\x12\x18\n\x07task_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x07user_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\"0\n\x0cTaskResponse\x12 \n\x04task\x18\x01 \x01(\x0b\x32\x12.task_service.Task\"e\n\x0fMoveTaskRequest\x12\x18\n\x07task_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x18\n\x07user_id\x18\x02 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x1e\n\rnew_folder_id\x18\x03 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\"\x8d\x02\n\x12SearchTasksRequest\x12\x18\n\x07user_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x16\n\x05query\x18\x02 \x01(\tB\x07\xfa\x42\x04r\x02\x10\x01\x12\x16\n\tcompleted\x18\x03 \x01(\x08H\x00\x88\x01\x01\x12 \n\x08priority\x18\x04 \x01(\x05\x42\t\xfa\x42\x06\x1a\x04\x18\x05(\x01H\x01\x88\x01\x01\x12\x33\n\ndue_before\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x02\x88\x01\x01\x12,\n\npagination\x18\x06 \x01(\x0b\x32\x18.task_service.PaginationB\x0c\n\n_completedB\x0b\n\t_priorityB\r\n\x0b_due_before\"x\n\x13SearchTasksResponse\x12!\n\x05tasks\x18\x01 \x03(\x0b\x32\x12.task_service.Task\x12\x13\n\x0btotal_count\x18\x02 \x01(\x05\x12)\n\x0bnext_cursor\x18\x03 \x01(\x0b\x32\x14.task_service.Cursor\"\xb0\x01\n\tSortField\x12\x38\n\x05\x66ield\x18\x01 \x01(\x0e\x32\x1d.task_service.SortField.FieldB\n\xfa\x42\x07\x82\x01\x04\x10\x01 \x00\x12\x12\n\ndescending\x18\x02 \x01(\x08\"U\n\x05\x46ield\x12\x15\n\x11\x46IELD_UNSPECIFIED\x10\x00\x12\x0c\n\x08\x44UE_TIME\x10\x01\x12\x0c\n\x08PRIORITY\x10\x02\x12\x0e\n\nCREATED_AT\x10\x03\x12\t\n\x05TITLE\x10\x04\"\xe1\x03\n\x12GetAllTasksRequest\x12\x18\n\x07user_id\x18\x01 \x01(\x05\x42\x07\xfa\x42\x04\x1a\x02 \x00\x12\x16\n\tfolder_id\x18\x02 \x01(\x05H\x00\x88\x01\x01\x12,\n\npagination\x18\x03 \x01(\x0b\x32\x18.task_service.Pagination\x12/\n\x04sort\x18\x04 \x03(\x0b\x32\x17.task_service.SortFieldB\x08\xfa\x42\x05\x92\x01\x02\x10\x04\x12\x32\n\tdue_after\x18\x05 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x01\x88\x01\x01\x12\x33\n\ndue_before\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x02\x88\x01\x01\x12\x36\n\rcreated_after\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.TimestampH\x03\x88\x01\x01\x12%\n\x0bpriority_in\x18\x08 \x03(\x05\x42\x10\xfa\x42\r\x92\x01\n\x18\x01\"\x06\x1a\x04\x18\x05(\x01\x12\x16\n\tcompleted\x18\t \x01(\x08H\x04\x88\x01\x01\x12\x0f\n\x07overdue\x18\n \x01(\x08\x42\x0c\n\n_folder_idB\x0c\n\n_due_afterB\r\n\x0b_due_beforeB\x10\n\x0e_created_afterB\x0c\n\n_completed\"x\n\x13GetAllTasksResponse\x12!\n\x05tasks\x18\x01 \x03(\x0b\x32\x12.task_service.Task\x12\x13\n\x0btotal_count\x18\x02 \x01(\x05\x12)\n\x0bnext_cursor\x18\x03 \x01(\x0b\x32\x14.task_service.Cursor2\xbc\x08\n\x0bTaskService\x12S\n\x0eGetUserFolders\x12\x1f.task_service.GetFoldersRequest\x1a .task_service.GetFoldersResponse\x12R\n\x0bGetAllTasks\x12 .task_service.GetAllTasksRequest\x1a!.task_service.GetAllTasksResponse\x12S\n\x14ToggleTaskCompletion\x12\x1f.task_service.ToggleTaskRequest\x1a\x1a.task_service.TaskResponse\x12M\n\x10MoveTaskToFolder\x12\x1d.task_service.MoveTaskRequest\x1a\x1a.task_service.TaskResponse\x12R\n\x0bSearchTasks\x12 .task_service.SearchTasksRequest\x1a!.task_service.SearchTasksResponse\x12U\n\x0c\x43reateFolder\x12!.task_service.CreateFolderRequest\x1a\".task_service.CreateFolderResponse\x12U\n\x0cUpdateFolder\x12!.task_service.UpdateFolderRequest\x1a\".task_service.UpdateFolderResponse\x12L\n\tGetFolder\x12\x1e.task_service.GetFolderRequest\x1a\x1f.task_service.GetFolderResponse\x12U\n\x0c\x44\x65leteFolder\x12!.task_service.DeleteFolderRequest\x1a\".task_service.DeleteFolderResponse\x12O\n\nCreateTask\x12\x1f.task_service.CreateTaskRequest\x1a .task_service.CreateTaskResponse\x12\x46\n\x07GetTask\x12\x1c.task_service.GetTaskRequest\x1a\x1d.task_service.GetTaskResponse\x12O\n\nUpdateTask\x12\x1f.task_service.UpdateTaskRequest\x1a .task_service.UpdateTaskResponse\x12O\n\nDeleteTask\x12\x1f.task_service.DeleteTaskRequest\x1a .task_service.DeleteTaskResponseb\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -34,6 +34,8 @@ if not _descriptor._USE_C_DESCRIPTORS:
   DESCRIPTOR._loaded_options = None
   _globals['_PAGINATION'].fields_by_name['limit']._loaded_options = None
   _globals['_PAGINATION'].fields_by_name['limit']._serialized_options = b'\372B\006\032\004\030d(\001'
+  _globals['_CURSOR'].fields_by_name['id']._loaded_options = None
+  _globals['_CURSOR'].fields_by_name['id']._serialized_options = b'\372B\004\032\002 \000'
   _globals['_FOLDER'].fields_by_name['user_id']._loaded_options = None
   _globals['_FOLDER'].fields_by_name['user_id']._serialized_options = b'\372B\004\032\002 \000'
   _globals['_FOLDER'].fields_by_name['name']._loaded_options = None
@@ -114,64 +116,74 @@ if not _descriptor._USE_C_DESCRIPTORS:
   _globals['_SEARCHTASKSREQUEST'].fields_by_name['query']._serialized_options = b'\372B\004r\002\020\001'
   _globals['_SEARCHTASKSREQUEST'].fields_by_name['priority']._loaded_options = None
   _globals['_SEARCHTASKSREQUEST'].fields_by_name['priority']._serialized_options = b'\372B\006\032\004\030\005(\001'
+  _globals['_SORTFIELD'].fields_by_name['field']._loaded_options = None
+  _globals['_SORTFIELD'].fields_by_name['field']._serialized_options = b'\372B\007\202\001\004\020\001 \000'
   _globals['_GETALLTASKSREQUEST'].fields_by_name['user_id']._loaded_options = None
   _globals['_GETALLTASKSREQUEST'].fields_by_name['user_id']._serialized_options = b'\372B\004\032\002 \000'
+  _globals['_GETALLTASKSREQUEST'].fields_by_name['sort']._loaded_options = None
+  _globals['_GETALLTASKSREQUEST'].fields_by_name['sort']._serialized_options = b'\372B\005\222\001\002\020\004'
+  _globals['_GETALLTASKSREQUEST'].fields_by_name['priority_in']._loaded_options = None
+  _globals['_GETALLTASKSREQUEST'].fields_by_name['priority_in']._serialized_options = b'\372B\r\222\001\n\030\001\"\006\032\004\030\005(\001'
   _globals['_PAGINATION']._serialized_start=86
-  _globals['_PAGINATION']._serialized_end=140
-  _globals['_FOLDER']._serialized_start=143
-  _globals['_FOLDER']._serialized_end=287
-  _globals['_GETFOLDERSREQUEST']._serialized_start=289
-  _globals['_GETFOLDERSREQUEST']._serialized_end=334
-  _globals['_GETFOLDERSRESPONSE']._serialized_start=336
-  _globals['_GETFOLDERSRESPONSE']._serialized_end=395
-  _globals['_GETFOLDERREQUEST']._serialized_start=397
-  _globals['_GETFOLDERREQUEST']._serialized_end=469
-  _globals['_GETFOLDERRESPONSE']._serialized_start=471
-  _globals['_GETFOLDERRESPONSE']._serialized_end=545
-  _globals['_CREATEFOLDERREQUEST']._serialized_start=547
-  _globals['_CREATEFOLDERREQUEST']._serialized_end=619
-  _globals['_CREATEFOLDERRESPONSE']._serialized_start=621
-  _globals['_CREATEFOLDERRESPONSE']._serialized_end=698
-  _globals['_UPDATEFOLDERREQUEST']._serialized_start=700
-  _globals['_UPDATEFOLDERREQUEST']._serialized_end=804
-  _globals['_UPDATEFOLDERRESPONSE']._serialized_start=806
-  _globals['_UPDATEFOLDERRESPONSE']._serialized_end=883
-  _globals['_DELETEFOLDERREQUEST']._serialized_start=885
-  _globals['_DELETEFOLDERREQUEST']._serialized_end=960
-  _globals['_DELETEFOLDERRESPONSE']._serialized_start=962
-  _globals['_DELETEFOLDERRESPONSE']._serialized_end=1018
-  _globals['_TASK']._serialized_start=1021
-  _globals['_TASK']._serialized_end=1348
-  _globals['_CREATETASKREQUEST']._serialized_start=1351
-  _globals['_CREATETASKREQUEST']._serialized_end=1556
-  _globals['_CREATETASKRESPONSE']._serialized_start=1558
-  _globals['_CREATETASKRESPONSE']._serialized_end=1629
-  _globals['_GETTASKREQUEST']._serialized_start=1631
-  _globals['_GETTASKREQUEST']._serialized_end=1699
-  _globals['_GETTASKRESPONSE']._serialized_start=1701
-  _globals['_GETTASKRESPONSE']._serialized_end=1769
-  _globals['_UPDATETASKREQUEST']._serialized_start=1772
-  _globals['_UPDATETASKREQUEST']._serialized_end=2094
-  _globals['_UPDATETASKRESPONSE']._serialized_start=2096
-  _globals['_UPDATETASKRESPONSE']._serialized_end=2167
-  _globals['_DELETETASKREQUEST']._serialized_start=2169
-  _globals['_DELETETASKREQUEST']._serialized_end=2240
-  _globals['_DELETETASKRESPONSE']._serialized_start=2242
-  _globals['_DELETETASKRESPONSE']._serialized_end=2296
-  _globals['_TOGGLETASKREQUEST']._serialized_start=2298
-  _globals['_TOGGLETASKREQUEST']._serialized_end=2369
-  _globals['_TASKRESPONSE']._serialized_start=2371
-  _globals['_TASKRESPONSE']._serialized_end=2419
-  _globals['_MOVETASKREQUEST']._serialized_start=2421
-  _globals['_MOVETASKREQUEST']._serialized_end=2522
-  _globals['_SEARCHTASKSREQUEST']._serialized_start=2525
-  _globals['_SEARCHTASKSREQUEST']._serialized_end=2794
-  _globals['_SEARCHTASKSRESPONSE']._serialized_start=2796
-  _globals['_SEARCHTASKSRESPONSE']._serialized_end=2873
-  _globals['_GETALLTASKSREQUEST']._serialized_start=2875
-  _globals['_GETALLTASKSREQUEST']._serialized_end=2959
-  _globals['_GETALLTASKSRESPONSE']._serialized_start=2961
-  _globals['_GETALLTASKSRESPONSE']._serialized_end=3038
-  _globals['_TASKSERVICE']._serialized_start=3041
-  _globals['_TASKSERVICE']._serialized_end=4125
+  _globals['_PAGINATION']._serialized_end=192
+  _globals['_CURSOR']._serialized_start=194
+  _globals['_CURSOR']._serialized_end=244
+  _globals['_FOLDER']._serialized_start=247
+  _globals['_FOLDER']._serialized_end=391
+  _globals['_GETFOLDERSREQUEST']._serialized_start=393
+  _globals['_GETFOLDERSREQUEST']._serialized_end=484
+  _globals['_GETFOLDERSRESPONSE']._serialized_start=486
+  _globals['_GETFOLDERSRESPONSE']._serialized_end=588
+  _globals['_GETFOLDERREQUEST']._serialized_start=590
+  _globals['_GETFOLDERREQUEST']._serialized_end=662
+  _globals['_GETFOLDERRESPONSE']._serialized_start=664
+  _globals['_GETFOLDERRESPONSE']._serialized_end=738
+  _globals['_CREATEFOLDERREQUEST']._serialized_start=740
+  _globals['_CREATEFOLDERREQUEST']._serialized_end=812
+  _globals['_CREATEFOLDERRESPONSE']._serialized_start=814
+  _globals['_CREATEFOLDERRESPONSE']._serialized_end=891
+  _globals['_UPDATEFOLDERREQUEST']._serialized_start=893
+  _globals['_UPDATEFOLDERREQUEST']._serialized_end=997
+  _globals['_UPDATEFOLDERRESPONSE']._serialized_start=999
+  _globals['_UPDATEFOLDERRESPONSE']._serialized_end=1076
+  _globals['_DELETEFOLDERREQUEST']._serialized_start=1078
+  _globals['_DELETEFOLDERREQUEST']._serialized_end=1153
+  _globals['_DELETEFOLDERRESPONSE']._serialized_start=1155
+  _globals['_DELETEFOLDERRESPONSE']._serialized_end=1211
+  _globals['_TASK']._serialized_start=1214
+  _globals['_TASK']._serialized_end=1541
+  _globals['_CREATETASKREQUEST']._serialized_start=1544
+  _globals['_CREATETASKREQUEST']._serialized_end=1749
+  _globals['_CREATETASKRESPONSE']._serialized_start=1751
+  _globals['_CREATETASKRESPONSE']._serialized_end=1822
+  _globals['_GETTASKREQUEST']._serialized_start=1824
+  _globals['_GETTASKREQUEST']._serialized_end=1892
+  _globals['_GETTASKRESPONSE']._serialized_start=1894
+  _globals['_GETTASKRESPONSE']._serialized_end=1962
+  _globals['_UPDATETASKREQUEST']._serialized_start=1965
+  _globals['_UPDATETASKREQUEST']._serialized_end=2287
+  _globals['_UPDATETASKRESPONSE']._serialized_start=2289
+  _globals['_UPDATETASKRESPONSE']._serialized_end=2360
+  _globals['_DELETETASKREQUEST']._serialized_start=2362
+  _globals['_DELETETASKREQUEST']._serialized_end=2433
+  _globals['_DELETETASKRESPONSE']._serialized_start=2435
+  _globals['_DELETETASKRESPONSE']._serialized_end=2489
+  _globals['_TOGGLETASKREQUEST']._serialized_start=2491
+  _globals['_TOGGLETASKREQUEST']._serialized_end=2562
+  _globals['_TASKRESPONSE']._serialized_start=2564
+  _globals['_TASKRESPONSE']._serialized_end=2612
+  _globals['_MOVETASKREQUEST']._serialized_start=2614
+  _globals['_MOVETASKREQUEST']._serialized_end=2715
+  _globals['_SEARCHTASKSREQUEST']._serialized_start=2718
+  _globals['_SEARCHTASKSREQUEST']._serialized_end=2987
+  _globals['_SEARCHTASKSRESPONSE']._serialized_start=2989
+  _globals['_SEARCHTASKSRESPONSE']._serialized_end=3109
+  _globals['_SORTFIELD']._serialized_start=3112
+  _globals['_SORTFIELD']._serialized_end=3288
+  _globals['_GETALLTASKSREQUEST']._serialized_start=3291
+  _globals['_GETALLTASKSREQUEST']._serialized_end=3772
+  _globals['_GETALLTASKSRESPONSE']._serialized_start=3774
+  _globals['_GETALLTASKSRESPONSE']._serialized_end=3894
+  _globals['_TASKSERVICE']._serialized_start=3897
+  _globals['_TASKSERVICE']._serialized_end=4981
 # @@protoc_insertion_point(module_scope)
@@ -1,6 +1,6 @@
 from sqlalchemy import create_engine
 from sqlalchemy.orm import sessionmaker, Session
-from sqlalchemy.exc import SQLAlchemyError
+from sqlalchemy.exc import IntegrityError, SQLAlchemyError
 from dotenv import load_dotenv
 import os
 from models import User
@@ -23,6 +23,10 @@ def create_tables():
 
 
 
+class UserAlreadyExists(Exception):
+    """Логин или почта уже заняты"""
+
+
 def CreateUser(db: Session, user_data: UserCreate):
     # Проверяем, нет ли такого пользователя
     existing_user = db.query(User).filter(
@@ -31,7 +35,7 @@ def CreateUser(db: Session, user_data: UserCreate):
     ).first()
     
     if existing_user:
-        raise ValueError("User already exists")
+        raise UserAlreadyExists("User already exists")
     
     # Создаем объект пользователя
     db_user = User(
@@ -41,9 +45,14 @@ def CreateUser(db: Session, user_data: UserCreate):
         full_name=user_data.full_name
         )
     
-    # Сохраняем в базу
+    # Сохраняем в базу. Параллельная регистрация с тем же логином проходит
+    # проверку выше и упирается в unique индекс
     db.add(db_user)
-    db.commit()
+    try:
+        db.commit()
+    except IntegrityError:
+        db.rollback()
+        raise UserAlreadyExists("User already exists")
     db.refresh(db_user)
     
     return db_user
@@ -1,6 +1,7 @@
 import threading
 from prometheus_client import start_http_server, Counter, Gauge, Histogram
 import service
+import tracing
 import time
 from database import create_tables
 
@@ -38,6 +39,9 @@ if __name__ == '__main__':
     metrics_thread = threading.Thread(target=run_metrics_server, daemon=True)
     metrics_thread.start()
     
+    # Интерсептор OpenTelemetry должен быть установлен до создания grpc.server()
+    tracing.setup("user_service")
+
     time.sleep(3)  # Ожидание инициализации
     create_tables()
     service.serve()
@@ -24,6 +24,8 @@ logger = logging.getLogger(__name__)
 SECRET_KEY = os.getenv("SECRET_KEY", "default-secret-key")
 ALGORITHM = "HS256"
 ACCESS_TOKEN_EXPIRE_MINUTES = 30
+# Должен совпадать с JWT_ISSUER в api_service (пустой - без iss)
+ISSUER = os.getenv("JWT_ISSUER", "")
 
 class AuthService:
     @staticmethod
@@ -44,7 +46,9 @@ class AuthService:
             expire = datetime.utcnow() + expires_delta
         else:
             expire = datetime.utcnow() + timedelta(minutes=15)
-        to_encode.update({"exp": expire})
+        to_encode.update({"exp": expire, "iat": datetime.utcnow()})
+        if ISSUER:
+            to_encode.update({"iss": ISSUER})
         return jwt.encode(to_encode, SECRET_KEY, algorithm=ALGORITHM)
 
     @staticmethod
@@ -84,8 +88,16 @@ class UserService(user_grpc.UserServiceServicer):
                 full_name=user.full_name or ""
             )
             
-        except ValueError as e:
+        except database.UserAlreadyExists as e:
             logger.error(f"Error creating user: {str(e)}")
+            context.set_code(grpc.StatusCode.ALREADY_EXISTS)
+            context.set_details(str(e))
+            return pb2.UserResponse(error=str(e))
+        except ValueError as e:
+            # Сюда же попадает pydantic.ValidationError (неверная почта, короткий логин)
+            logger.error(f"Invalid user data: {str(e)}")
+            context.set_code(grpc.StatusCode.INVALID_ARGUMENT)
+            context.set_details(str(e))
             return pb2.UserResponse(error=str(e))
     
     def Login(self, request: pb2.LoginRequest, context):
@@ -95,14 +107,18 @@ class UserService(user_grpc.UserServiceServicer):
             user = database.GetUser(self.db, UserGet(username=request.username))
             if not user:
                 logger.warning(f"User not found: {request.username}")
+                context.set_code(grpc.StatusCode.UNAUTHENTICATED)
+                context.set_details("Invalid username or password")
                 return pb2.LoginResponse(access_token="")
             
             if not AuthService.verify_password(request.password, user.hashed_password):
                 logger.warning(f"Invalid password for user: {request.username}")
+                context.set_code(grpc.StatusCode.UNAUTHENTICATED)
+                context.set_details("Invalid username or password")
                 return pb2.LoginResponse(access_token="")
             
             access_token = AuthService.create_access_token(
-                data={"sub": user.username},
+                data={"sub": user.username, "uid": user.id},
                 expires_delta=timedelta(minutes=ACCESS_TOKEN_EXPIRE_MINUTES)
             )
             
@@ -114,6 +130,8 @@ class UserService(user_grpc.UserServiceServicer):
             
         except Exception as e:
             logger.error(f"Login error: {str(e)}")
+            context.set_code(grpc.StatusCode.INTERNAL)
+            context.set_details(str(e))
             return pb2.LoginResponse(access_token="")
     
     def GetUser(self, request: pb2.GetUserRequest, context):